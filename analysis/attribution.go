@@ -0,0 +1,52 @@
+package analysis
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"strings"
+)
+
+// printAttribution prints live functions of packages imported by multiple entrypoints, with entrypoints reaching them.
+// Functions reached by exactly one entrypoint are candidates to move into internal package of that entrypoint.
+func (r *Runner) printAttribution(eps []*entrypointInfo) {
+	live := make(map[string]deadPackageFuncs)
+	reachedBy := make(map[string][]*entrypointInfo)
+	for _, ep := range eps {
+		for pkg, dpf := range ep.liveCode {
+			liveDpf, found := live[pkg]
+			if !found {
+				liveDpf = deadPackageFuncs{
+					pkg:   &Package{Name: dpf.pkg.Name, Path: dpf.pkg.Path},
+					funcs: make(map[string]*Function),
+				}
+				live[pkg] = liveDpf
+			}
+			maps.Copy(liveDpf.funcs, dpf.funcs)
+			for key := range dpf.funcs {
+				reachedBy[pkg+":"+key] = append(reachedBy[pkg+":"+key], ep)
+			}
+		}
+	}
+
+	for _, pkg := range sortedPackages(live) {
+		importing := r.importingEntrypoints(eps, pkg.Path)
+		if len(importing) < 2 {
+			continue
+		}
+		fmt.Fprintf(r.writer, "%s imported by %d entrypoints:\n", pkg.Path, len(importing))
+		for _, fun := range pkg.Funcs {
+			reaching := reachedBy[pkg.Path+":"+funcKey(fun)]
+			names := make([]string, 0, len(reaching))
+			for _, ep := range reaching {
+				names = append(names, r.entrypointName(ep))
+			}
+			if len(reaching) == 1 {
+				fmt.Fprintf(r.writer, "\t%s: %s reached only by %s, candidate to move into %s\n",
+					fun.Position, fun.Name, names[0], filepath.Join(filepath.Dir(names[0]), "internal"))
+				continue
+			}
+			fmt.Fprintf(r.writer, "\t%s: %s reached by %s\n", fun.Position, fun.Name, strings.Join(names, ", "))
+		}
+	}
+}
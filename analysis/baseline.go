@@ -0,0 +1,54 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// writeBaseline records current findings into WriteBaselineFlag file, in the same format as JSON output.
+func (r *Runner) writeBaseline(deadCode map[string]deadPackageFuncs) error {
+	err := writeFileAtomic(r.WriteBaselineFlag, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(sortedPackages(deadCode))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write baseline file: %w", err)
+	}
+	r.writeDebug("Baseline written to %s", r.WriteBaselineFlag)
+	return nil
+}
+
+// applyBaseline removes functions recorded in BaselineFlag file from the dead code.
+// Functions are matched by package path and name, so unrelated edits moving them around don't invalidate baseline.
+func (r *Runner) applyBaseline(deadCode map[string]deadPackageFuncs) error {
+	data, err := os.ReadFile(r.BaselineFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read baseline file: %w", err)
+	}
+	var baseline []*Package
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return fmt.Errorf("failed to parse baseline file %s: %w", r.BaselineFlag, err)
+	}
+
+	known := make(map[string]struct{})
+	for _, pkg := range baseline {
+		for _, fun := range pkg.Funcs {
+			known[pkg.Path+"."+fun.Name] = struct{}{}
+		}
+	}
+
+	removed := 0
+	for pkg, dpf := range deadCode {
+		for key, fun := range dpf.funcs {
+			if _, found := known[pkg+"."+fun.Name]; found {
+				delete(dpf.funcs, key)
+				removed++
+			}
+		}
+	}
+	r.writeDebug("Baseline removed %d known dead functions", removed)
+	return nil
+}
@@ -0,0 +1,138 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BuildConfig is a build configuration the entrypoints are analyzed with.
+// Empty fields mean the value of the current environment.
+type BuildConfig struct {
+	GOOS       string
+	GOARCH     string
+	Tags       string   // comma-separated list of extra build tags
+	CGOEnabled string   // value of CGO_ENABLED, "0" or "1"
+	Env        []string // extra environment variables in KEY=VALUE format
+}
+
+// buildSettingKeys are keys of per-entrypoint build settings, see ParseEntrypoint.
+var buildSettingKeys = []string{"tags", "goos", "goarch", "cgo", "env"}
+
+// ParseBuildConfig parses build configuration in format `goos/goarch[:tags]`, for example `linux/arm64:rpi`.
+// Both goos and goarch can be empty to keep the value of current environment, for example `:rpi` or `windows/`.
+func ParseBuildConfig(s string) (BuildConfig, error) {
+	platform, tags, _ := strings.Cut(s, ":")
+	goos, goarch, found := strings.Cut(platform, "/")
+	if !found && platform != "" {
+		return BuildConfig{}, fmt.Errorf("invalid build configuration '%s', expected goos/goarch[:tags]", s)
+	}
+	return BuildConfig{GOOS: goos, GOARCH: goarch, Tags: tags}, nil
+}
+
+// ParseEntrypoint parses entrypoint argument with optional build settings in format
+// `path@key=value,key=value`, for example `services/edge/main.go@tags=rpi,goarch=arm64`.
+// Supported keys are tags, goos, goarch, cgo (value of CGO_ENABLED) and env (KEY=VALUE, can be repeated).
+// Values containing commas, like multiple tags, continue until the next key: `tags=rpi,debug,goos=linux`.
+// Returns nil config if the argument has no build settings.
+func ParseEntrypoint(arg string) (string, *BuildConfig, error) {
+	path, settings := splitEntrypoint(arg)
+	if settings == "" {
+		return path, nil, nil
+	}
+
+	config := &BuildConfig{}
+	var key, value string
+	apply := func() error {
+		switch key {
+		case "tags":
+			config.Tags = value
+		case "goos":
+			config.GOOS = value
+		case "goarch":
+			config.GOARCH = value
+		case "cgo":
+			if value != "0" && value != "1" {
+				return fmt.Errorf("invalid cgo build setting '%s' of entrypoint '%s', expected 0 or 1", value, path)
+			}
+			config.CGOEnabled = value
+		case "env":
+			if !strings.Contains(value, "=") {
+				return fmt.Errorf("invalid env build setting '%s' of entrypoint '%s', expected KEY=VALUE", value, path)
+			}
+			config.Env = append(config.Env, value)
+		}
+		return nil
+	}
+	for _, part := range strings.Split(settings, ",") {
+		if !isBuildSetting(part) {
+			// Continuation of previous value, like the second tag in `tags=rpi,debug`.
+			value += "," + part
+			continue
+		}
+		if err := apply(); err != nil {
+			return "", nil, err
+		}
+		key, value, _ = strings.Cut(part, "=")
+	}
+	if err := apply(); err != nil {
+		return "", nil, err
+	}
+	return path, config, nil
+}
+
+// splitEntrypoint splits entrypoint argument to path and raw build settings.
+func splitEntrypoint(arg string) (path, settings string) {
+	i := strings.LastIndex(arg, "@")
+	if i < 0 || !isBuildSetting(arg[i+1:]) {
+		return arg, ""
+	}
+	return arg[:i], arg[i+1:]
+}
+
+func isBuildSetting(s string) bool {
+	key, _, found := strings.Cut(s, "=")
+	return found && slices.Contains(buildSettingKeys, key)
+}
+
+func (c BuildConfig) String() string {
+	s := c.GOOS + "/" + c.GOARCH
+	if c.Tags != "" {
+		s += ":" + c.Tags
+	}
+	if c.CGOEnabled != "" {
+		s += " CGO_ENABLED=" + c.CGOEnabled
+	}
+	for _, env := range c.Env {
+		s += " " + env
+	}
+	return s
+}
+
+// env returns environment variables to be appended to the current environment of `go` command.
+func (c BuildConfig) env() []string {
+	env := make([]string, 0, 3+len(c.Env))
+	env = append(env, c.Env...)
+	if c.GOOS != "" {
+		env = append(env, "GOOS="+c.GOOS)
+	}
+	if c.GOARCH != "" {
+		env = append(env, "GOARCH="+c.GOARCH)
+	}
+	if c.CGOEnabled != "" {
+		env = append(env, "CGO_ENABLED="+c.CGOEnabled)
+	}
+	return env
+}
+
+// buildTags combines global tags with tags of the build configuration.
+func (c BuildConfig) buildTags(tags string) string {
+	switch {
+	case tags == "":
+		return c.Tags
+	case c.Tags == "":
+		return tags
+	default:
+		return tags + "," + c.Tags
+	}
+}
@@ -0,0 +1,59 @@
+package analysis_test
+
+import (
+	"github.com/arxeiss/deadmono/analysis"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildConfig", func() {
+	DescribeTable("Parses build configuration",
+		func(s string, expected analysis.BuildConfig) {
+			c, err := analysis.ParseBuildConfig(s)
+			Expect(err).To(Succeed())
+			Expect(c).To(Equal(expected))
+		},
+		Entry("Platform", "linux/amd64", analysis.BuildConfig{GOOS: "linux", GOARCH: "amd64"}),
+		Entry("Platform with tags", "linux/arm64:rpi,debug",
+			analysis.BuildConfig{GOOS: "linux", GOARCH: "arm64", Tags: "rpi,debug"}),
+		Entry("Only GOOS", "windows/", analysis.BuildConfig{GOOS: "windows"}),
+		Entry("Only tags", ":rpi", analysis.BuildConfig{Tags: "rpi"}),
+	)
+
+	It("Fails on missing GOARCH separator", func() {
+		_, err := analysis.ParseBuildConfig("linux")
+		Expect(err).To(MatchError("invalid build configuration 'linux', expected goos/goarch[:tags]"))
+	})
+
+	DescribeTable("Parses entrypoint with build settings",
+		func(arg, expectedPath string, expected *analysis.BuildConfig) {
+			path, c, err := analysis.ParseEntrypoint(arg)
+			Expect(err).To(Succeed())
+			Expect(path).To(Equal(expectedPath))
+			Expect(c).To(Equal(expected))
+		},
+		Entry("Without settings", "services/edge/main.go", "services/edge/main.go", nil),
+		Entry("Module cache path", "/go/pkg/mod/example.com/x@v1.0.0/main.go",
+			"/go/pkg/mod/example.com/x@v1.0.0/main.go", nil),
+		Entry("Tags and GOARCH", "services/edge/main.go@tags=rpi,goarch=arm64", "services/edge/main.go",
+			&analysis.BuildConfig{GOARCH: "arm64", Tags: "rpi"}),
+		Entry("Multiple tags", "main.go@tags=rpi,debug,goos=linux", "main.go",
+			&analysis.BuildConfig{GOOS: "linux", Tags: "rpi,debug"}),
+		Entry("CGO and env", "main.go@cgo=0,env=GOAMD64=v3,env=GOEXPERIMENT=foo", "main.go",
+			&analysis.BuildConfig{CGOEnabled: "0", Env: []string{"GOAMD64=v3", "GOEXPERIMENT=foo"}}),
+	)
+
+	DescribeTable("Fails on invalid build settings",
+		func(arg, expectedErr string) {
+			_, _, err := analysis.ParseEntrypoint(arg)
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("CGO", "main.go@cgo=yes", "invalid cgo build setting 'yes' of entrypoint 'main.go', expected 0 or 1"),
+		Entry("Env", "main.go@env=FOO", "invalid env build setting 'FOO' of entrypoint 'main.go', expected KEY=VALUE"),
+	)
+
+	It("Formats build configuration", func() {
+		Expect(analysis.BuildConfig{GOOS: "linux", GOARCH: "arm64", Tags: "rpi"}.String()).To(Equal("linux/arm64:rpi"))
+	})
+})
@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"slices"
+	"strings"
+)
+
+type (
+	checkstyleReport struct {
+		XMLName xml.Name         `xml:"checkstyle"`
+		Version string           `xml:"version,attr"`
+		Files   []checkstyleFile `xml:"file"`
+	}
+
+	checkstyleFile struct {
+		Name   string            `xml:"name,attr"`
+		Errors []checkstyleError `xml:"error"`
+	}
+
+	checkstyleError struct {
+		Line     int    `xml:"line,attr"`
+		Column   int    `xml:"column,attr"`
+		Severity string `xml:"severity,attr"`
+		Message  string `xml:"message,attr"`
+		Source   string `xml:"source,attr"`
+	}
+)
+
+// printCheckstyle prints dead functions as Checkstyle XML report grouped per file.
+func (r *Runner) printCheckstyle(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	byFile := make(map[string][]checkstyleError)
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			ruleID, severity := ruleUnreachableFunc, "warning"
+			if fun.Generated {
+				ruleID, severity = ruleGenerated, "info"
+			}
+			byFile[fun.Position.File] = append(byFile[fun.Position.File], checkstyleError{
+				Line:     fun.Position.Line,
+				Column:   fun.Position.Col,
+				Severity: severity,
+				Message:  "unreachable func: " + fun.Name,
+				Source:   toolName + "." + ruleID,
+			})
+		}
+	}
+
+	report := checkstyleReport{Version: "5.0", Files: make([]checkstyleFile, 0, len(byFile))}
+	for name, errs := range byFile {
+		report.Files = append(report.Files, checkstyleFile{Name: name, Errors: errs})
+	}
+	slices.SortFunc(report.Files, func(a, b checkstyleFile) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
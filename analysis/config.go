@@ -0,0 +1,205 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigFileName is a name of project configuration file, which is searched in the working directory and its parents.
+const ConfigFileName = ".deadmono.yaml"
+
+type (
+	// Config is a project configuration, usually loaded from .deadmono.yaml file at the module root.
+	Config struct {
+		// Entrypoints are paths or glob patterns of main files, optionally with build settings (see ParseEntrypoint).
+		// Relative paths are resolved against the directory of the configuration file.
+		Entrypoints []string `yaml:"entrypoints"`
+		// Filter is a regular expression to filter packages by.
+		Filter string `yaml:"filter"`
+		// Tags is a comma-separated list of extra build tags.
+		Tags string `yaml:"tags"`
+		// Matrix is a list of build configurations in format goos/goarch[:tags], see ParseBuildConfig.
+		Matrix []string `yaml:"matrix"`
+		// Ignore are regular expressions of functions which are never reported.
+		// They are matched against fully qualified function name (example.com/pkg.Type.Method) and file path.
+		Ignore []string `yaml:"ignore"`
+		// Jobs is a maximum number of entrypoints scanned concurrently.
+		Jobs int `yaml:"jobs"`
+		// Test turns on reporting of dead functions in test files.
+		Test bool `yaml:"test"`
+		// Generated turns on reporting of dead functions in generated Go files.
+		Generated bool `yaml:"generated"`
+		// UnusedPackages turns on reporting of packages not imported by any entrypoint nor test.
+		UnusedPackages bool `yaml:"unused_packages"`
+		// DeadFiles turns on reporting of files whose declared functions are all dead.
+		DeadFiles bool `yaml:"dead_files"`
+		// ShowSuppressed turns on printing of findings suppressed by inline directives.
+		ShowSuppressed bool `yaml:"show_suppressed"`
+		// CheckSuppressions turns on failing on inline directives suppressing live functions.
+		CheckSuppressions bool `yaml:"check_suppressions"`
+		// Baseline is a path to baseline file, functions recorded in it are not reported.
+		// Relative path is resolved against the directory of the configuration file.
+		Baseline string `yaml:"baseline"`
+		// Output specifies output settings.
+		Output OutputConfig `yaml:"output"`
+	}
+
+	// OutputConfig specifies output settings of project configuration.
+	OutputConfig struct {
+		// JSON turns on JSON output.
+		JSON bool `yaml:"json"`
+		// Format is an output format, one of OutputFormats.
+		Format string `yaml:"format"`
+		// Files are extra output files in format format=path, see ParseOutput.
+		// Relative paths are resolved against the directory of the configuration file.
+		Files []string `yaml:"files"`
+		// HTML is a path to file, where self-contained HTML report is written.
+		// Relative path is resolved against the directory of the configuration file.
+		HTML string `yaml:"html"`
+		// SourceURL is a template of links to source lines in markdown output, see Runner.SourceURLFlag.
+		SourceURL string `yaml:"source_url"`
+		// Template is a text/template used instead of the default text output, see Runner.TemplateFlag.
+		Template string `yaml:"template"`
+		// TemplatePerFunc turns on executing Template per function instead of per package.
+		TemplatePerFunc bool `yaml:"template_per_func"`
+	}
+)
+
+// FindConfig searches for ConfigFileName in the dir and all its parents.
+// Returns empty string if no configuration file is found.
+func FindConfig(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert '%s' to absolute path: %w", dir, err)
+	}
+	for {
+		path := filepath.Join(absDir, ConfigFileName)
+		_, err := os.Stat(path)
+		if err == nil {
+			return path, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("failed to check configuration file: %w", err)
+		}
+		parent := filepath.Dir(absDir)
+		if parent == absDir {
+			return "", nil
+		}
+		absDir = parent
+	}
+}
+
+// LoadConfig reads and parses project configuration file.
+// Relative entrypoints, baseline and output files are resolved against the directory of the configuration file.
+func LoadConfig(path string) (*Config, error) {
+	f, err := os.Open(path) //nolint:gosec // path to configuration file is expected to be provided by user
+	if err != nil {
+		return nil, fmt.Errorf("failed to open configuration file: %w", err)
+	}
+	defer f.Close()
+
+	cfg := &Config{}
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse configuration file %s: %w", path, err)
+	}
+
+	absDir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert '%s' to absolute path: %w", path, err)
+	}
+	for i, ep := range cfg.Entrypoints {
+		if !filepath.IsAbs(ep) {
+			cfg.Entrypoints[i] = filepath.Join(absDir, ep)
+		}
+	}
+	if cfg.Baseline != "" && !filepath.IsAbs(cfg.Baseline) {
+		cfg.Baseline = filepath.Join(absDir, cfg.Baseline)
+	}
+	for i, file := range cfg.Output.Files {
+		if format, path, found := strings.Cut(file, "="); found && path != "" && !filepath.IsAbs(path) {
+			cfg.Output.Files[i] = format + "=" + filepath.Join(absDir, path)
+		}
+	}
+	if cfg.Output.HTML != "" && !filepath.IsAbs(cfg.Output.HTML) {
+		cfg.Output.HTML = filepath.Join(absDir, cfg.Output.HTML)
+	}
+	return cfg, nil
+}
+
+// NewFromConfig creates runner for analysis from project configuration.
+// Glob patterns of entrypoints are expanded, so each must match at least one file.
+func NewFromConfig(writer, errWriter io.Writer, cfg *Config) (*Runner, error) {
+	paths := make([]string, 0, len(cfg.Entrypoints))
+	for _, ep := range cfg.Entrypoints {
+		path, settings := splitEntrypoint(ep)
+		if !strings.ContainsAny(path, "*?[") {
+			paths = append(paths, ep)
+			continue
+		}
+
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entrypoint pattern '%s': %w", path, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("entrypoint pattern '%s' matched no files", path)
+		}
+		slices.Sort(matches)
+		for _, match := range matches {
+			if settings != "" {
+				match += "@" + settings
+			}
+			paths = append(paths, match)
+		}
+	}
+
+	matrix := make([]BuildConfig, 0, len(cfg.Matrix))
+	for _, s := range cfg.Matrix {
+		c, err := ParseBuildConfig(s)
+		if err != nil {
+			return nil, err
+		}
+		matrix = append(matrix, c)
+	}
+
+	outputs := make([]Output, 0, len(cfg.Output.Files))
+	for _, s := range cfg.Output.Files {
+		o, err := ParseOutput(s)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, o)
+	}
+
+	r := New(writer, errWriter, paths)
+	r.FilterFlag = cfg.Filter
+	r.TagsFlag = cfg.Tags
+	r.Matrix = matrix
+	r.Ignore = cfg.Ignore
+	r.JobsFlag = cfg.Jobs
+	r.TestFlag = cfg.Test
+	r.GeneratedFlag = cfg.Generated
+	r.UnusedPackagesFlag = cfg.UnusedPackages
+	r.DeadFilesFlag = cfg.DeadFiles
+	r.ShowSuppressedFlag = cfg.ShowSuppressed
+	r.CheckSuppressionsFlag = cfg.CheckSuppressions
+	r.BaselineFlag = cfg.Baseline
+	r.JSONFlag = cfg.Output.JSON
+	r.FormatFlag = cfg.Output.Format
+	r.Outputs = outputs
+	r.HTMLFlag = cfg.Output.HTML
+	r.SourceURLFlag = cfg.Output.SourceURL
+	r.TemplateFlag = cfg.Output.Template
+	r.TemplatePerFuncFlag = cfg.Output.TemplatePerFunc
+	return r, nil
+}
@@ -0,0 +1,73 @@
+package analysis_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/arxeiss/deadmono/analysis"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	It("Finds configuration file in parent directory", func() {
+		path, err := analysis.FindConfig("testdata/allinone/services/authn")
+		Expect(err).To(Succeed())
+
+		absPath, err := filepath.Abs("testdata/allinone/.deadmono.yaml")
+		Expect(err).To(Succeed())
+		Expect(path).To(Equal(absPath))
+	})
+
+	It("Returns empty path when configuration file is not found", func() {
+		path, err := analysis.FindConfig("testdata/cli")
+		Expect(err).To(Succeed())
+		Expect(path).To(BeEmpty())
+	})
+
+	It("Loads configuration file", func() {
+		cfg, err := analysis.LoadConfig("testdata/allinone/.deadmono.yaml")
+		Expect(err).To(Succeed())
+
+		absDir, err := filepath.Abs("testdata/allinone")
+		Expect(err).To(Succeed())
+		Expect(cfg).To(Equal(&analysis.Config{
+			Entrypoints: []string{filepath.Join(absDir, "services/*/main.go")},
+			Ignore:      []string{`/pkg/cache\.Delete$`, `services/authn/internal/generated\.go$`},
+			Generated:   true,
+		}))
+	})
+
+	It("Fails on unknown field", func() {
+		path := filepath.Join(GinkgoT().TempDir(), analysis.ConfigFileName)
+		Expect(os.WriteFile(path, []byte("entrypoint: main.go\n"), 0o600)).To(Succeed())
+
+		_, err := analysis.LoadConfig(path)
+		Expect(err).To(MatchError(ContainSubstring("field entrypoint not found in type analysis.Config")))
+	})
+
+	It("Runs analysis from configuration file", func() {
+		stdOut := bytes.NewBuffer(nil)
+		cfg, err := analysis.LoadConfig("testdata/allinone/.deadmono.yaml")
+		Expect(err).To(Succeed())
+
+		r, err := analysis.NewFromConfig(stdOut, GinkgoWriter, cfg)
+		Expect(err).To(Succeed())
+		Expect(r.Run(context.Background())).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n" +
+				"analysis/testdata/allinone/services/authn/internal/auth.go:18:6: unreachable func: RunFromTest\n",
+		))
+	})
+
+	It("Fails on entrypoint pattern without matches", func() {
+		_, err := analysis.NewFromConfig(GinkgoWriter, GinkgoWriter, &analysis.Config{
+			Entrypoints: []string{"testdata/nonexisting/*/main.go@tags=rpi"},
+		})
+		Expect(err).To(MatchError("entrypoint pattern 'testdata/nonexisting/*/main.go' matched no files"))
+	})
+})
@@ -0,0 +1,44 @@
+package analysis
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// csvHeader are columns of CSV output.
+var csvHeader = []string{
+	"package_path", "package_name", "function", "file", "line", "column", "generated", "marker", "importing_entrypoints",
+}
+
+// printCSV prints one row per dead function with the number of entrypoints importing its package.
+func (r *Runner) printCSV(
+	_ context.Context, w io.Writer, eps []*entrypointInfo, deadCode map[string]deadPackageFuncs,
+) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, pkg := range sortedPackages(deadCode) {
+		importing := strconv.Itoa(len(r.importingEntrypoints(eps, pkg.Path)))
+		for _, fun := range pkg.Funcs {
+			err := cw.Write([]string{
+				pkg.Path,
+				pkg.Name,
+				fun.Name,
+				fun.Position.File,
+				strconv.Itoa(fun.Position.Line),
+				strconv.Itoa(fun.Position.Col),
+				strconv.FormatBool(fun.Generated),
+				strconv.FormatBool(fun.Marker),
+				importing,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
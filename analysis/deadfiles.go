@@ -0,0 +1,86 @@
+package analysis
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// fileDecls summarizes declarations of a single file.
+type fileDecls struct {
+	// funcs is a number of declared functions and methods, which can be reported as dead.
+	funcs int
+	// other is true if the file declares types, vars, consts or init functions, which might be still used.
+	other bool
+}
+
+// deadFile is a file, whose declared functions are all dead.
+type deadFile struct {
+	file  string
+	funcs int
+	other bool
+}
+
+// fileDecl returns declarations of the file, which are created on the first access.
+func (ep *entrypointInfo) fileDecl(file string) *fileDecls {
+	decls, found := ep.fileDecls[file]
+	if !found {
+		decls = &fileDecls{}
+		ep.fileDecls[file] = decls
+	}
+	return decls
+}
+
+// declaresOther reports whether the declaration is a type, var, const or init function.
+func declaresOther(decl ast.Decl) bool {
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		return decl.Tok != token.IMPORT
+	case *ast.FuncDecl:
+		return decl.Recv == nil && decl.Name.Name == "init"
+	default:
+		return false
+	}
+}
+
+// deadFiles returns files with at least one function, whose all declared functions are reported as dead.
+// Files are the same in all entrypoints compiling them, so declarations are taken from any of them.
+func deadFiles(eps []*entrypointInfo, deadCode map[string]deadPackageFuncs) []*deadFile {
+	dead := make(map[string]int)
+	for _, dpf := range deadCode {
+		for _, fun := range dpf.funcs {
+			dead[fun.Position.File]++
+		}
+	}
+
+	files := make([]*deadFile, 0)
+	for file, count := range dead {
+		for _, ep := range eps {
+			decls, found := ep.fileDecls[file]
+			if !found {
+				continue
+			}
+			if decls.funcs == count {
+				files = append(files, &deadFile{file: file, funcs: count, other: decls.other})
+			}
+			break
+		}
+	}
+	slices.SortFunc(files, func(a, b *deadFile) int {
+		return strings.Compare(a.file, b.file)
+	})
+	return files
+}
+
+// printDeadFiles prints dead files in the same form as unreachable functions in text output.
+func (r *Runner) printDeadFiles(files []*deadFile) {
+	for _, f := range files {
+		if f.other {
+			r.printFinding("%s: unreachable file: all funcs are unreachable (%d), "+
+				"but it declares types or vars which might be used", f.file, f.funcs)
+			continue
+		}
+		r.printFinding("%s: unreachable file: all funcs are unreachable (%d)", f.file, f.funcs)
+	}
+}
@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// DiscoverEntrypoints finds all main packages matching given patterns and returns path to a Go file of each of them.
+// The dir is a working directory where patterns are resolved, empty means current directory.
+// Without patterns, all main packages under the module root are discovered,
+// or under roots of all workspace modules within a go.work workspace.
+// Like `go list ./...`, directories named testdata and vendor are skipped.
+// Include and exclude are optional regular expressions matched against the package import path.
+func DiscoverEntrypoints(
+	ctx context.Context, dir string, patterns []string, include, exclude string,
+) ([]string, error) {
+	includeRe, excludeRe, err := compileDiscoverFilters(include, exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(patterns) == 0 {
+		// Within a workspace, `go list -m` prints root of each workspace module on separate line.
+		out, err := getCommandStdout(ctx, dir, "go", "list", "-m", "-f", "{{.Dir}}")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list module root: %w", err)
+		}
+		for _, moduleDir := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			patterns = append(patterns, filepath.Join(moduleDir, "..."))
+		}
+	}
+
+	args := append([]string{
+		"list", "-f", `{{if eq .Name "main"}}{{.ImportPath}}{{"\t"}}{{.Dir}}{{"\t"}}{{join .GoFiles ","}}{{end}}`,
+	}, patterns...)
+	out, err := getCommandStdout(ctx, dir, "go", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover main packages: %w", err)
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert '%s' to absolute path: %w", dir, err)
+	}
+
+	paths := make([]string, 0)
+	for _, line := range strings.Split(string(out), "\n") {
+		// Packages other than main print empty line, as well as main packages with only cgo files.
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 || fields[2] == "" {
+			continue
+		}
+		importPath, pkgDir, files := fields[0], fields[1], strings.Split(fields[2], ",")
+		if includeRe != nil && !includeRe.MatchString(importPath) {
+			continue
+		}
+		if excludeRe != nil && excludeRe.MatchString(importPath) {
+			continue
+		}
+
+		// Only the directory of entrypoint matters, but prefer main.go as it is the most natural choice.
+		file := files[0]
+		if slices.Contains(files, "main.go") {
+			file = "main.go"
+		}
+		path := filepath.Join(pkgDir, file)
+		if rel, err := filepath.Rel(absDir, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+func compileDiscoverFilters(include, exclude string) (includeRe, excludeRe *regexp.Regexp, err error) {
+	if include != "" {
+		includeRe, err = regexp.Compile(include)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid include pattern: %w", err)
+		}
+	}
+	if exclude != "" {
+		excludeRe, err = regexp.Compile(exclude)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid exclude pattern: %w", err)
+		}
+	}
+	return includeRe, excludeRe, nil
+}
@@ -0,0 +1,78 @@
+package analysis_test
+
+import (
+	"context"
+
+	"github.com/arxeiss/deadmono/analysis"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiscoverEntrypoints", func() {
+	DescribeTable("Discovers main packages",
+		func(include, exclude string, expected []string) {
+			ctx := context.Background()
+			paths, err := analysis.DiscoverEntrypoints(ctx, "", []string{"./testdata/allinone/..."}, include, exclude)
+			Expect(err).To(Succeed())
+			Expect(paths).To(Equal(expected))
+		},
+		Entry("All", "", "", []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		}),
+		Entry("Include", "/services/(authn|config)$", "", []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+		}),
+		Entry("Exclude", "", "healthcheck", []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+		}),
+		Entry("Include and exclude", "services", "authn|config", []string{
+			"testdata/allinone/services/healthcheck/main.go",
+		}),
+	)
+
+	It("Discovers main packages under module root by default", func() {
+		ctx := context.Background()
+		paths, err := analysis.DiscoverEntrypoints(ctx, "testdata/cli", nil, "", "")
+		Expect(err).To(Succeed())
+		Expect(paths).To(Equal([]string{"main.go"}))
+	})
+
+	It("Discovers main packages of all workspace modules by default", func() {
+		// Workspace mode doesn't allow -mod=mod, which might be set globally.
+		GinkgoT().Setenv("GOFLAGS", "")
+
+		ctx := context.Background()
+		paths, err := analysis.DiscoverEntrypoints(ctx, "testdata/workspace", nil, "", "")
+		Expect(err).To(Succeed())
+		Expect(paths).To(Equal([]string{"services/api/main.go", "services/worker/main.go"}))
+	})
+
+	It("Skips testdata when discovering under module root", func() {
+		ctx := context.Background()
+		paths, err := analysis.DiscoverEntrypoints(ctx, "", nil, "", "")
+		Expect(err).To(Succeed())
+		// Paths outside of working directory are absolute.
+		Expect(paths).To(ConsistOf(HaveSuffix("/cmd/deadmono/main.go")))
+	})
+
+	It("Ignores warnings of go list", func() {
+		ctx := context.Background()
+		// Nested modules are not matched, so go list prints warning to stderr, but doesn't fail.
+		paths, err := analysis.DiscoverEntrypoints(ctx, "", []string{
+			"./testdata/allinone/services/config/...", "./testdata/workspace/...",
+		}, "", "")
+		Expect(err).To(Succeed())
+		Expect(paths).To(Equal([]string{"testdata/allinone/services/config/main.go"}))
+	})
+
+	It("Fails on invalid exclude pattern", func() {
+		ctx := context.Background()
+		_, err := analysis.DiscoverEntrypoints(ctx, "", nil, "", "*")
+		Expect(err).To(MatchError(HavePrefix("invalid exclude pattern: error parsing regexp")))
+	})
+})
@@ -0,0 +1,29 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// printGitHub prints dead functions as GitHub Actions workflow commands, so they are annotated inline on PR diffs.
+// See https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+func (r *Runner) printGitHub(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) {
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			fmt.Fprintf(w, "::warning file=%s,line=%d,col=%d::%s\n",
+				escapeGitHubProperty(r.checkoutPath("GITHUB_WORKSPACE", fun.Position.File)), fun.Position.Line, fun.Position.Col,
+				escapeGitHubData("unreachable func: "+fun.Name),
+			)
+		}
+	}
+}
+
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
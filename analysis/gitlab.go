@@ -0,0 +1,60 @@
+package analysis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+)
+
+type (
+	gitlabIssue struct {
+		Description string         `json:"description"`
+		CheckName   string         `json:"check_name"`
+		Fingerprint string         `json:"fingerprint"`
+		Severity    string         `json:"severity"`
+		Location    gitlabLocation `json:"location"`
+	}
+
+	gitlabLocation struct {
+		Path  string      `json:"path"`
+		Lines gitlabLines `json:"lines"`
+	}
+
+	gitlabLines struct {
+		Begin int `json:"begin"`
+	}
+)
+
+// printGitLab prints dead functions as GitLab Code Quality report, paths are relative to $CI_PROJECT_DIR.
+// See https://docs.gitlab.com/ci/testing/code_quality/#code-quality-report-format
+func (r *Runner) printGitLab(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	issues := make([]gitlabIssue, 0)
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			ruleID, severity := ruleUnreachableFunc, "minor"
+			if fun.Generated {
+				ruleID, severity = ruleGenerated, "info"
+			}
+			// Fingerprint doesn't contain line, so the issue is the same when the function is moved within the file.
+			// File is needed, as the same function can be declared in multiple files with different build constraints.
+			path := r.checkoutPath("CI_PROJECT_DIR", fun.Position.File)
+			sum := sha256.Sum256([]byte(ruleID + ":" + path + ":" + pkg.Path + "." + fun.Name))
+			issues = append(issues, gitlabIssue{
+				Description: "unreachable func: " + fun.Name,
+				CheckName:   ruleID,
+				Fingerprint: hex.EncodeToString(sum[:]),
+				Severity:    severity,
+				Location: gitlabLocation{
+					Path:  path,
+					Lines: gitlabLines{Begin: fun.Position.Line},
+				},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(issues)
+}
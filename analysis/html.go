@@ -0,0 +1,169 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	_ "embed"
+)
+
+// htmlSnippetBefore and htmlSnippetAfter are numbers of source lines shown around dead function declaration.
+const (
+	htmlSnippetBefore = 2
+	htmlSnippetAfter  = 8
+)
+
+var (
+	//go:embed html.tmpl
+	htmlTemplateText string
+
+	htmlTemplate = template.Must(template.New("html").Parse(htmlTemplateText))
+)
+
+type (
+	htmlReport struct {
+		Count       int
+		Entrypoints []string
+		Packages    []*htmlPackage
+		Tree        *htmlNode
+	}
+
+	// htmlNode is a node of package tree, split by import path segments.
+	// Chains of nodes with single child and without package are collapsed into one node.
+	htmlNode struct {
+		Name     string
+		Count    int
+		Package  *htmlPackage
+		Children []*htmlNode
+	}
+
+	htmlPackage struct {
+		Name        string
+		Path        string
+		Entrypoints []string
+		Funcs       []*htmlFunc
+	}
+
+	htmlFunc struct {
+		*Function
+		Snippet []htmlLine
+	}
+
+	htmlLine struct {
+		Number    int
+		Text      string
+		Highlight bool
+	}
+)
+
+// writeHTML writes self-contained HTML report with package tree into HTMLFlag file.
+func (r *Runner) writeHTML(eps []*entrypointInfo, deadCode map[string]deadPackageFuncs) error {
+	report := &htmlReport{Tree: &htmlNode{}}
+	seen := make(map[string]bool)
+	for _, ep := range eps {
+		if !seen[ep.absPath] {
+			seen[ep.absPath] = true
+			report.Entrypoints = append(report.Entrypoints, r.entrypointName(ep))
+		}
+	}
+
+	sources := make(map[string][]string)
+	for _, pkg := range sortedPackages(deadCode) {
+		hp := &htmlPackage{Name: pkg.Name, Path: pkg.Path, Entrypoints: r.importingEntrypoints(eps, pkg.Path)}
+		for _, fun := range pkg.Funcs {
+			hp.Funcs = append(hp.Funcs, &htmlFunc{Function: fun, Snippet: r.snippet(sources, fun.Position)})
+		}
+		report.Count += len(hp.Funcs)
+		report.Packages = append(report.Packages, hp)
+		report.Tree.add(strings.Split(pkg.Path, "/"), hp)
+	}
+	report.Tree.collapse()
+
+	err := writeFileAtomic(r.HTMLFlag, func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+		if err := htmlTemplate.Execute(bw, report); err != nil {
+			return err
+		}
+		return bw.Flush()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write HTML report: %w", err)
+	}
+	r.writeDebug("HTML report written to %s", r.HTMLFlag)
+	return nil
+}
+
+// importingEntrypoints returns names of entrypoints importing the package, each entrypoint only once.
+func (r *Runner) importingEntrypoints(eps []*entrypointInfo, pkgPath string) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, ep := range eps {
+		if _, found := ep.deps[pkgPath]; found && !seen[ep.absPath] {
+			seen[ep.absPath] = true
+			names = append(names, r.entrypointName(ep))
+		}
+	}
+	return names
+}
+
+// snippet returns source lines around the position, files are read only once and cached in sources.
+// Returns nil if the file cannot be read, snippet is not essential for the report.
+func (r *Runner) snippet(sources map[string][]string, pos Position) []htmlLine {
+	lines, found := sources[pos.File]
+	if !found {
+		path := pos.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(r.rootPath, path)
+		}
+		data, err := os.ReadFile(path) //nolint:gosec // path of analyzed Go file
+		if err != nil {
+			r.writeDebug("Failed to read source of %s: %s", path, err)
+		} else {
+			lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		}
+		sources[pos.File] = lines
+	}
+	if pos.Line > len(lines) {
+		return nil
+	}
+
+	from := max(pos.Line-htmlSnippetBefore, 1)
+	to := min(pos.Line+htmlSnippetAfter, len(lines))
+	snippet := make([]htmlLine, 0, to-from+1)
+	for n := from; n <= to; n++ {
+		snippet = append(snippet, htmlLine{Number: n, Text: lines[n-1], Highlight: n == pos.Line})
+	}
+	return snippet
+}
+
+func (n *htmlNode) add(segments []string, pkg *htmlPackage) {
+	n.Count += len(pkg.Funcs)
+	if len(segments) == 0 {
+		n.Package = pkg
+		return
+	}
+	i := slices.IndexFunc(n.Children, func(c *htmlNode) bool { return c.Name == segments[0] })
+	if i < 0 {
+		n.Children = append(n.Children, &htmlNode{Name: segments[0]})
+		i = len(n.Children) - 1
+	}
+	n.Children[i].add(segments[1:], pkg)
+}
+
+func (n *htmlNode) collapse() {
+	for _, c := range n.Children {
+		for c.Package == nil && len(c.Children) == 1 {
+			child := c.Children[0]
+			c.Name += "/" + child.Name
+			c.Package = child.Package
+			c.Children = child.Children
+		}
+		c.collapse()
+	}
+}
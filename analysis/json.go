@@ -1,5 +1,7 @@
 package analysis
 
+import "fmt"
+
 // Package represents a Go package with its dead functions.
 type Package struct {
 	Name  string      // declared name
@@ -12,7 +14,7 @@ type Function struct {
 	Name      string   // name (sans package qualifier)
 	Position  Position // file/line/column of function declaration
 	Generated bool     // function is declared in a generated .go file
-	Marker    bool     // function is a marker interface method
+	Marker    bool     // function is a marker interface method, always false as those are not reported
 }
 
 // Position represents a position in a source file.
@@ -20,3 +22,8 @@ type Position struct {
 	File      string // name of file
 	Line, Col int    // line and byte index, both 1-based
 }
+
+// String returns the position in file:line:col form, as it is printed in text output.
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Col)
+}
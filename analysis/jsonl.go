@@ -0,0 +1,27 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// jsonlRecord is a self-contained record of dead function in JSON Lines output.
+type jsonlRecord struct {
+	Package     string // full import path of the package
+	PackageName string // declared name of the package
+	*Function
+}
+
+// printJSONL prints one JSON object per dead function, suitable for line-oriented processing.
+func (r *Runner) printJSONL(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	enc := json.NewEncoder(w)
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			if err := enc.Encode(jsonlRecord{Package: pkg.Path, PackageName: pkg.Name, Function: fun}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
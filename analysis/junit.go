@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Name     string           `xml:"name,attr"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Time     string           `xml:"time,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name     string          `xml:"name,attr"`
+		Tests    int             `xml:"tests,attr"`
+		Failures int             `xml:"failures,attr"`
+		Time     string          `xml:"time,attr"`
+		Cases    []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Body    string `xml:",cdata"`
+	}
+)
+
+// printJUnit prints JUnit XML report, where each entrypoint scan is a test suite and each analyzed package
+// is a test case. Test case fails when the package contains dead functions.
+func (r *Runner) printJUnit(
+	_ context.Context, w io.Writer, eps []*entrypointInfo, deadCode map[string]deadPackageFuncs,
+) error {
+	pkgs := make(map[string]*Package)
+	for _, pkg := range sortedPackages(deadCode) {
+		pkgs[pkg.Path] = pkg
+	}
+
+	report := junitTestSuites{Name: toolName, Suites: make([]junitTestSuite, 0, len(eps))}
+	var total time.Duration
+	for _, ep := range eps {
+		name := r.scanName(ep)
+		suite := junitTestSuite{Name: name, Time: junitTime(ep.duration)}
+		for _, pkgPath := range slices.Sorted(maps.Keys(ep.packages)) {
+			tc := junitTestCase{Name: pkgPath, ClassName: name}
+			if pkg, found := pkgs[pkgPath]; found {
+				lines := make([]string, 0, len(pkg.Funcs))
+				for _, fun := range pkg.Funcs {
+					lines = append(lines, fmt.Sprintf("%s: unreachable func: %s", fun.Position, fun.Name))
+				}
+				tc.Failure = &junitFailure{
+					Message: fmt.Sprintf("%d unreachable funcs", len(pkg.Funcs)),
+					Type:    ruleUnreachableFunc,
+					Body:    strings.Join(lines, "\n"),
+				}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		suite.Tests = len(suite.Cases)
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		total += ep.duration
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = junitTime(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
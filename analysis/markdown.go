@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// printMarkdown prints summary of dead functions for pull request comments.
+// Functions are linked to the source, if SourceURLFlag is set.
+func (r *Runner) printMarkdown(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) {
+	pkgs := sortedPackages(deadCode)
+	count := 0
+	for _, pkg := range pkgs {
+		count += len(pkg.Funcs)
+	}
+
+	fmt.Fprintf(w, "## %s report\n\n", toolName)
+	if count == 0 {
+		fmt.Fprintln(w, "No unreachable funcs found.")
+		return
+	}
+	fmt.Fprintf(w, "Found **%d** unreachable funcs in **%d** packages.\n\n", count, len(pkgs))
+
+	fmt.Fprintln(w, "| Package | Unreachable funcs |")
+	fmt.Fprintln(w, "| --- | ---: |")
+	for _, pkg := range pkgs {
+		fmt.Fprintf(w, "| `%s` | %d |\n", pkg.Path, len(pkg.Funcs))
+	}
+
+	for _, pkg := range pkgs {
+		fmt.Fprintf(w, "\n<details>\n<summary><code>%s</code> (%d)</summary>\n\n", pkg.Path, len(pkg.Funcs))
+		for _, fun := range pkg.Funcs {
+			name := "`" + fun.Name + "`"
+			if url := r.sourceURL(fun.Position); url != "" {
+				name = "[" + name + "](" + url + ")"
+			}
+			fmt.Fprintf(w, "- %s `%s`\n", name, fun.Position)
+		}
+		fmt.Fprintln(w, "\n</details>")
+	}
+}
+
+// sourceURL returns link to the source line built from SourceURLFlag template, or empty string if it is not set.
+// Path is relative to the repository checkout, functions outside of it, like in module cache, are not linked.
+func (r *Runner) sourceURL(pos Position) string {
+	if r.SourceURLFlag == "" {
+		return ""
+	}
+	path := r.ciCheckoutPath(pos.File)
+	if filepath.IsAbs(path) {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{commit}", r.CommitFlag,
+		"{path}", filepath.ToSlash(path),
+		"{line}", strconv.Itoa(pos.Line),
+	).Replace(r.SourceURLFlag)
+}
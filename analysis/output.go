@@ -0,0 +1,65 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Output is an extra output destination, the file is written in the format.
+type Output struct {
+	Format string
+	Path   string
+}
+
+// ParseOutput parses output destination in format `format=path`, for example `sarif=report.sarif`.
+func ParseOutput(s string) (Output, error) {
+	format, path, found := strings.Cut(s, "=")
+	if !found || format == "" || path == "" {
+		return Output{}, fmt.Errorf("invalid output '%s', expected format=path", s)
+	}
+	return Output{Format: format, Path: path}, nil
+}
+
+func (o Output) String() string {
+	return o.Format + "=" + o.Path
+}
+
+// writeOutput writes dead code into the output file atomically, so partially written file is never observed.
+func (r *Runner) writeOutput(
+	ctx context.Context, o Output, eps []*entrypointInfo, deadCode map[string]deadPackageFuncs,
+) error {
+	err := writeFileAtomic(o.Path, func(w io.Writer) error {
+		return r.print(ctx, w, o.Format, eps, deadCode)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write output %s: %w", o, err)
+	}
+	r.writeDebug("Output %s written", o)
+	return nil
+}
+
+// writeFileAtomic writes into temporary file in the same directory, which is renamed to the path when done.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck // file doesn't exist anymore after successful rename
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	// Temporary file is created with 0600 permissions, use the same as os.Create would.
+	if err := os.Chmod(f.Name(), 0o644); err != nil { //nolint:gosec // reports are meant to be readable
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
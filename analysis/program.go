@@ -0,0 +1,428 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/callgraph/rta"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// program is SSA representation of all entrypoints within single Go module.
+// It is loaded and type-checked only once and shared by all entrypoints of the module.
+type program struct {
+	prog      *ssa.Program
+	initial   []*packages.Package
+	generated map[string]bool
+}
+
+// analyzeEntrypoints loads all entrypoints in one pass per Go module or workspace
+// and computes dead code of each entrypoint.
+func (r *Runner) analyzeEntrypoints(ctx context.Context, eps []*entrypointInfo) error {
+	// Entrypoints from different modules cannot be loaded by single `go list` call, so group them by module.
+	// Within a workspace, `go list` can load packages from all workspace modules at once.
+	groups := make([]string, 0)
+	byGroup := make(map[string][]*entrypointInfo)
+	for _, ep := range eps {
+		group := ep.module
+		if ep.workspace != "" {
+			group = ep.workspace
+		}
+		// Each build configuration needs to be loaded separately.
+		group += "@" + ep.config.String()
+		if _, found := byGroup[group]; !found {
+			groups = append(groups, group)
+		}
+		byGroup[group] = append(byGroup[group], ep)
+	}
+
+	filters := make([]*regexp.Regexp, len(groups))
+	for i, group := range groups {
+		modules := []string{byGroup[group][0].module}
+		if byGroup[group][0].workspace != "" && byGroup[group][0].workspace == r.workspaceDir {
+			modules = r.workspaceModules
+		}
+		var err error
+		filters[i], err = r.compileFilter(modules)
+		if err != nil {
+			return err
+		}
+	}
+
+	progs := make([]*program, len(groups))
+	g, gctx := r.newErrGroup(ctx)
+	for i, group := range groups {
+		g.Go(func() error {
+			var err error
+			progs[i], err = r.loadProgram(gctx, byGroup[group])
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// The SSA program is fully built, so reachability of entrypoints can be computed concurrently.
+	g, gctx = r.newErrGroup(ctx)
+	for i, group := range groups {
+		for _, ep := range byGroup[group] {
+			g.Go(func() error {
+				if err := gctx.Err(); err != nil {
+					return err
+				}
+				ep.deps = progs[i].entrypointDeps(filepath.Dir(ep.absPath))
+				r.writeDebug("Detected %d dependencies of %s", len(ep.deps), ep.absPath)
+				var err error
+				ep.deadCode, err = r.listEntrypointDeadCode(progs[i], ep, filters[i])
+				return err
+			})
+		}
+	}
+	return g.Wait()
+}
+
+func (r *Runner) loadProgram(ctx context.Context, eps []*entrypointInfo) (*program, error) {
+	patterns := make([]string, 0, len(eps))
+	for _, ep := range eps {
+		patterns = append(patterns, filepath.Join(filepath.Dir(ep.absPath), "..."))
+	}
+	group := eps[0].module
+	if eps[0].workspace != "" {
+		group = eps[0].workspace
+	}
+	if len(r.Matrix) > 0 {
+		group += " with build configuration " + eps[0].config.String()
+	}
+
+	r.writeDebug("Starting to load %d entrypoint(s) of module %s, might take a while", len(eps), group)
+	timeStart := time.Now()
+
+	cfg := &packages.Config{
+		Context:    ctx,
+		Dir:        filepath.Dir(eps[0].absPath),
+		BuildFlags: []string{"-tags=" + eps[0].config.buildTags(r.TagsFlag)},
+		Mode:       packages.LoadAllSyntax | packages.NeedModule,
+		Tests:      r.TestFlag,
+	}
+	if env := eps[0].config.env(); len(env) > 0 {
+		cfg.Env = append(os.Environ(), env...)
+	}
+	initial, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	if len(initial) == 0 {
+		return nil, fmt.Errorf("no packages found in module %s", group)
+	}
+	pkgErrs := make([]string, 0)
+	packages.Visit(initial, nil, func(p *packages.Package) {
+		for _, e := range p.Errors {
+			pkgErrs = append(pkgErrs, e.Error())
+		}
+	})
+	if len(pkgErrs) > 0 {
+		return nil, fmt.Errorf("failed to load packages: %s", strings.Join(pkgErrs, "\n"))
+	}
+
+	prog, _ := ssautil.AllPackages(initial, ssa.InstantiateGenerics)
+	prog.Build()
+	r.writeDebug("Loading module %s finished in %s", group, time.Since(timeStart))
+
+	p := &program{
+		prog:      prog,
+		initial:   initial,
+		generated: make(map[string]bool),
+	}
+	packages.Visit(initial, nil, func(pkg *packages.Package) {
+		for _, file := range pkg.Syntax {
+			if ast.IsGenerated(file) {
+				p.generated[pkg.Fset.File(file.Pos()).Name()] = true
+			}
+		}
+	})
+	return p, nil
+}
+
+// entrypointPackages returns initial packages matching `./...` pattern relative to the entrypoint directory.
+// With TestFlag, test variants and test executables of those packages are included as well.
+func (p *program) entrypointPackages(absDirPath string) []*packages.Package {
+	pkgs := make([]*packages.Package, 0)
+	for _, pkg := range p.initial {
+		if pkg.Dir == absDirPath || strings.HasPrefix(pkg.Dir, absDirPath+string(filepath.Separator)) {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	return pkgs
+}
+
+// entrypointDeps returns import paths of all packages transitively imported by the main package in the directory.
+// Unlike `go list -deps`, implicit dependencies like runtime are not included.
+// Test variants are not taken into account.
+func (p *program) entrypointDeps(absDirPath string) map[string]struct{} {
+	deps := make(map[string]struct{})
+	for _, pkg := range p.initial {
+		if pkg.Dir != absDirPath || pkg.Name != "main" || pkg.ID != pkg.PkgPath {
+			continue
+		}
+		for _, imp := range pkg.Imports {
+			packages.Visit([]*packages.Package{imp}, func(dep *packages.Package) bool {
+				if _, seen := deps[dep.PkgPath]; seen {
+					return false
+				}
+				deps[dep.PkgPath] = struct{}{}
+				return true
+			}, nil)
+		}
+	}
+	return deps
+}
+
+// compileFilter compiles FilterFlag, default filter matches any of given modules.
+func (r *Runner) compileFilter(modules []string) (*regexp.Regexp, error) {
+	filter := r.FilterFlag
+	// Same as deadcode, if filter is not set, use the module of the entrypoint.
+	// Within a workspace, all workspace modules are used instead.
+	if r.isDefaultFilter() {
+		quoted := make([]string, 0, len(modules))
+		for _, module := range modules {
+			quoted = append(quoted, regexp.QuoteMeta(module))
+		}
+		filter = `^(` + strings.Join(quoted, "|") + `)\b`
+	}
+	re, err := regexp.Compile(filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter flag: %w", err)
+	}
+	return re, nil
+}
+
+func (r *Runner) listEntrypointDeadCode(
+	p *program, ep *entrypointInfo, filter *regexp.Regexp,
+) (map[string]deadPackageFuncs, error) {
+	absDirPath := filepath.Dir(ep.absPath)
+	initial := p.entrypointPackages(absDirPath)
+
+	rootPath := ""
+	if r.workspaceDir != "" {
+		rootPath = filepath.Clean(r.workspaceDir) + string(filepath.Separator)
+	}
+	roots := make([]*ssa.Function, 0)
+	for _, pkg := range initial {
+		if rootPath == "" && pkg.Module != nil {
+			rootPath = filepath.Clean(pkg.Module.Dir) + string(filepath.Separator)
+		}
+		if pkg.Name != "main" {
+			continue
+		}
+		ssaPkg := p.prog.Package(pkg.Types)
+		if ssaPkg == nil {
+			continue
+		}
+		for _, name := range []string{"init", "main"} {
+			if fn := ssaPkg.Func(name); fn != nil {
+				roots = append(roots, fn)
+			}
+		}
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no main packages found in %s", absDirPath)
+	}
+	r.writeDebug("Detected root path: %s", rootPath)
+	ep.rootPath = rootPath
+
+	r.writeDebug("Starting to scan %s for deadcode", absDirPath)
+	timeStart := time.Now()
+
+	// Call graph is expensive, so it is built only when it is needed to explain why the function is live.
+	res := rta.Analyze(roots, r.WhyLiveFlag != "")
+	if r.WhyLiveFlag != "" {
+		ep.whyLive = explainWhyLive(p, ep.deps, roots, res, r.WhyLiveFlag)
+	}
+
+	// With TestFlag there are multiple distinct ssa.Function instances representing the same declaration
+	// (package and its test variants). De-duplicate them by position, if any of them is live, all are live.
+	reachablePosn := make(map[token.Position]bool)
+	for fn := range res.Reachable {
+		if fn.Pos().IsValid() || fn.Name() == "init" {
+			reachablePosn[p.prog.Fset.Position(fn.Pos())] = true
+		}
+	}
+
+	// If all entrypoints are within same module, we can remove the path to module root from the file path.
+	// Then it will be relative to go.mod file. Within a workspace, it will be relative to go.work file.
+	trimRoot := func(f string) string {
+		if r.hasCommonModule || r.workspaceDir != "" {
+			f, _ = strings.CutPrefix(f, rootPath)
+		}
+		return f
+	}
+
+	deadCode := map[string]deadPackageFuncs{}
+	seenPosn := make(map[token.Position]bool)
+	ep.liveCode = map[string]deadPackageFuncs{}
+	ep.fileDecls = make(map[string]*fileDecls)
+	ep.files = make(map[string]struct{})
+	ep.packages = make(map[string]struct{})
+	ep.suppressions = make(map[string]*suppression)
+	ep.pkgSuppressions = make(map[string]*suppression)
+	packages.Visit(initial, nil, func(pkg *packages.Package) {
+		if !filter.MatchString(pkg.PkgPath) {
+			return
+		}
+		ep.packages[pkg.PkgPath] = struct{}{}
+		interfaces := interfaceTypes(pkg.Types)
+		for _, file := range pkg.Syntax {
+			fileName := trimRoot(p.prog.Fset.File(file.Pos()).Name())
+			ep.files[fileName] = struct{}{}
+			if reason, pos, found := findDirective(ignorePackageDirective, file.Doc); found {
+				posn := p.prog.Fset.Position(pos)
+				ep.pkgSuppressions[pkg.PkgPath] = &suppression{
+					pkg:    &Package{Name: pkg.Name, Path: pkg.PkgPath},
+					pos:    Position{File: trimRoot(posn.Filename), Line: posn.Line, Col: posn.Column},
+					reason: reason,
+				}
+			}
+			for _, decl := range file.Decls {
+				if r.DeadFilesFlag && declaresOther(decl) {
+					ep.fileDecl(fileName).other = true
+				}
+				decl, ok := decl.(*ast.FuncDecl)
+				if !ok {
+					continue
+				}
+				obj, ok := pkg.TypesInfo.Defs[decl.Name].(*types.Func)
+				if !ok {
+					continue
+				}
+				fn := p.prog.FuncValue(obj)
+				if fn == nil {
+					continue
+				}
+				posn := p.prog.Fset.Position(fn.Pos())
+				if seenPosn[posn] {
+					continue
+				}
+				seenPosn[posn] = true // Suppress duplicates with same position.
+
+				// Without GeneratedFlag, skip functions declared in generated Go files.
+				// Functions called by them may still be reported.
+				generated := p.generated[posn.Filename]
+				if generated && !r.GeneratedFlag {
+					continue
+				}
+				// Marker methods are not reported, same as deadcode. They are often not called,
+				// yet they restrict the set of types implementing an interface.
+				if isMarkerMethod(fn, interfaces) {
+					if r.DeadFilesFlag {
+						ep.fileDecl(trimRoot(posn.Filename)).other = true
+					}
+					continue
+				}
+
+				fun := &Function{
+					Name:      funcName(fn),
+					Position:  Position{File: trimRoot(posn.Filename), Line: posn.Line, Col: posn.Column},
+					Generated: generated,
+				}
+				// Suppressions of live functions are collected too, so unnecessary ones can be reported.
+				// Functions skipped above are never reported, so their suppressions are not collected.
+				if reason, _, found := findDirective(ignoreDirective, decl.Doc); found {
+					ep.suppressions[suppressionKey(pkg.PkgPath, funcKey(fun))] = &suppression{
+						pkg:    &Package{Name: pkg.Name, Path: pkg.PkgPath},
+						fun:    fun,
+						reason: reason,
+					}
+				}
+				if r.DeadFilesFlag {
+					ep.fileDecl(fun.Position.File).funcs++
+				}
+				if reachablePosn[posn] {
+					if r.AttributionFlag {
+						addFunc(ep.liveCode, pkg, fun)
+					}
+					continue
+				}
+				addFunc(deadCode, pkg, fun)
+			}
+		}
+	})
+	ep.duration = time.Since(timeStart)
+	r.writeDebug("Scanning %s for deadcode finished in %s", absDirPath, ep.duration)
+
+	return deadCode, nil
+}
+
+// addFunc adds the function into functions of the package.
+func addFunc(code map[string]deadPackageFuncs, pkg *packages.Package, fun *Function) {
+	dpf, found := code[pkg.PkgPath]
+	if !found {
+		dpf = deadPackageFuncs{
+			pkg:   &Package{Name: pkg.Name, Path: pkg.PkgPath},
+			funcs: make(map[string]*Function),
+		}
+		code[pkg.PkgPath] = dpf
+	}
+	dpf.funcs[funcKey(fun)] = fun
+}
+
+// interfaceTypes returns all named interface types declared in the package scope.
+func interfaceTypes(pkg *types.Package) []*types.Interface {
+	interfaces := make([]*types.Interface, 0)
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		if typeName, ok := scope.Lookup(name).(*types.TypeName); ok && types.IsInterface(typeName.Type()) {
+			interfaces = append(interfaces, typeName.Type().Underlying().(*types.Interface))
+		}
+	}
+	return interfaces
+}
+
+// isMarkerMethod reports whether fn is a marker method, same as deadcode:
+// an unexported, empty-bodied method with no parameters or results
+// that implements some named interface type in the same package.
+func isMarkerMethod(fn *ssa.Function, interfaces []*types.Interface) bool {
+	if fn.Signature.Recv() == nil || ast.IsExported(fn.Name()) ||
+		fn.Signature.Params() != nil || fn.Signature.Results() != nil {
+		return false
+	}
+	decl, ok := fn.Syntax().(*ast.FuncDecl)
+	if !ok || decl.Body == nil || len(decl.Body.List) > 0 {
+		return false
+	}
+	return slices.ContainsFunc(interfaces, func(iface *types.Interface) bool {
+		return types.Implements(fn.Signature.Recv().Type(), iface)
+	})
+}
+
+// funcKey identifies the function within package. Name is not enough,
+// as the same function can be declared in multiple files guarded by different build constraints.
+func funcKey(fun *Function) string {
+	return fun.Position.File + ":" + fun.Name
+}
+
+// funcName returns name of the function without package qualifier, methods are prefixed with receiver type name.
+func funcName(fn *ssa.Function) string {
+	recv := fn.Signature.Recv()
+	if recv == nil {
+		return fn.Name()
+	}
+	t := types.Unalias(recv.Type())
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = types.Unalias(ptr.Elem())
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+	return fn.Name()
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+type (
+	rdjsonResult struct {
+		Source      rdjsonSource       `json:"source"`
+		Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+	}
+
+	rdjsonDiagnostic struct {
+		Message  string         `json:"message"`
+		Location rdjsonLocation `json:"location"`
+		Severity string         `json:"severity"`
+		Source   rdjsonSource   `json:"source"`
+		Code     rdjsonCode     `json:"code"`
+	}
+
+	rdjsonSource struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	}
+
+	rdjsonCode struct {
+		Value string `json:"value"`
+	}
+
+	rdjsonLocation struct {
+		Path  string      `json:"path"`
+		Range rdjsonRange `json:"range"`
+	}
+
+	rdjsonRange struct {
+		Start rdjsonPosition `json:"start"`
+		End   rdjsonPosition `json:"end"`
+	}
+
+	rdjsonPosition struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	}
+)
+
+// printRDJSON prints dead functions in reviewdog diagnostic format, as single result or one diagnostic per line.
+// Reviewdog matches paths against the diff, so they are relative to $GITHUB_WORKSPACE or $CI_PROJECT_DIR, if set.
+// See https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+func (r *Runner) printRDJSON(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs, lines bool) error {
+	source := rdjsonSource{Name: toolName, URL: toolURI}
+	diagnostics := make([]rdjsonDiagnostic, 0)
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			ruleID, severity := ruleUnreachableFunc, "WARNING"
+			if fun.Generated {
+				ruleID, severity = ruleGenerated, "INFO"
+			}
+			// Position points to the function name, for methods the name doesn't contain receiver type.
+			ident := fun.Name[strings.LastIndex(fun.Name, ".")+1:]
+			diagnostics = append(diagnostics, rdjsonDiagnostic{
+				Message: "unreachable func: " + fun.Name,
+				Location: rdjsonLocation{
+					Path: r.ciCheckoutPath(fun.Position.File),
+					Range: rdjsonRange{
+						Start: rdjsonPosition{Line: fun.Position.Line, Column: fun.Position.Col},
+						End:   rdjsonPosition{Line: fun.Position.Line, Column: fun.Position.Col + len(ident)},
+					},
+				},
+				Severity: severity,
+				Source:   source,
+				Code:     rdjsonCode{Value: ruleID},
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	if lines {
+		for _, d := range diagnostics {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	enc.SetIndent("", "\t")
+	return enc.Encode(rdjsonResult{Source: source, Diagnostics: diagnostics})
+}
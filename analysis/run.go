@@ -5,11 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
 	"slices"
 	"strings"
+	"sync"
+	"text/template"
 	"time"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/sync/errgroup"
 )
 
 type (
@@ -17,15 +26,33 @@ type (
 	Runner struct {
 		writer    io.Writer
 		errWriter io.Writer
+		errMu     sync.Mutex
+		template  *template.Template
 
 		// TagsFlag is a comma-separated list of extra build tags.
 		TagsFlag string
 		// FilterFlag is a regular expression to filter packages by.
 		FilterFlag string
 
-		commonModule    string
-		paths           []string
-		hasCommonModule bool
+		commonModule     string
+		workspaceDir     string
+		paths            []string
+		workspaceModules []string
+		hasCommonModule  bool
+		// rootPath is a directory relative paths of functions are relative to, empty if paths are absolute.
+		rootPath string
+
+		// Matrix is a list of build configurations, each entrypoint is analyzed with all of them.
+		// A function is reported only if it is dead in every configuration that compiles its file.
+		// Empty means single configuration of the current environment.
+		Matrix []BuildConfig
+		// Ignore are regular expressions of functions which are never reported.
+		// They are matched against fully qualified function name (example.com/pkg.Type.Method) and file path.
+		Ignore []string
+
+		// JobsFlag is a maximum number of entrypoints scanned concurrently.
+		// Zero or negative value means the number of CPUs.
+		JobsFlag int
 
 		// DebugFlag turns on more verbose output.
 		DebugFlag bool
@@ -33,22 +60,94 @@ type (
 		GeneratedFlag bool
 		// TestFlag turns on reporting of dead functions in test files.
 		TestFlag bool
-		// JSONFlag turns on JSONFlag output.
+		// JSONFlag turns on JSONFlag output, it is the same as FormatFlag "json".
 		JSONFlag bool
+		// FormatFlag is an output format, one of OutputFormats. Empty means "text".
+		FormatFlag string
+		// Outputs are files written in addition to the output printed to the writer, each with own format.
+		Outputs []Output
+		// HTMLFlag is a path to file, where self-contained HTML report is written.
+		HTMLFlag string
+		// SourceURLFlag is a template of links to source lines in markdown output,
+		// for example https://github.com/org/repo/blob/{commit}/{path}#L{line}.
+		SourceURLFlag string
+		// CommitFlag is a commit SHA substituted for {commit} in SourceURLFlag.
+		CommitFlag string
+		// TemplateFlag is a text/template used instead of the default text output.
+		// It is executed per Package with the same data as JSON output, same as deadcode -f flag.
+		TemplateFlag string
+		// TemplatePerFuncFlag turns on executing TemplateFlag per Function, with .Package field of its package.
+		TemplatePerFuncFlag bool
+		// WhyLiveFlag is a fully qualified function name (example.com/pkg.Type.Method).
+		// When set, instead of reporting dead code, it explains which entrypoints reach the function and how.
+		WhyLiveFlag string
+		// UnusedPackagesFlag turns on reporting of packages matching the filter,
+		// which are not imported by any entrypoint nor test.
+		UnusedPackagesFlag bool
+		// DeadFilesFlag turns on reporting of files, whose declared functions are all dead.
+		DeadFilesFlag bool
+		// AttributionFlag turns on reporting of live functions in packages imported by multiple entrypoints,
+		// with entrypoints reaching them, instead of reporting dead code.
+		AttributionFlag bool
+		// ShowSuppressedFlag turns on printing of findings suppressed by inline directives to stderr.
+		ShowSuppressedFlag bool
+		// CheckSuppressionsFlag turns on reporting of inline directives suppressing live functions.
+		// Run then fails, if there is any such unnecessary suppression.
+		CheckSuppressionsFlag bool
+		// BaselineFlag is a path to baseline file, functions recorded in it are not reported.
+		BaselineFlag string
+		// WriteBaselineFlag is a path to file, where current findings are recorded as a new baseline.
+		WriteBaselineFlag string
 	}
 
 	entrypointInfo struct {
 		deps     map[string]struct{}
 		deadCode map[string]deadPackageFuncs
+		// liveCode are reachable functions of packages matching the filter, collected only with AttributionFlag.
+		liveCode map[string]deadPackageFuncs
 		absPath  string
+		module   string
+		// workspace is a directory of go.work file, if the entrypoint is within Go workspace.
+		workspace string
+		config    BuildConfig
+		// files contains all files compiled in the configuration, in the same form as Position.File.
+		files map[string]struct{}
+		// suppressions are functions with ignore directive, both dead and live, indexed by suppressionKey.
+		suppressions map[string]*suppression
+		// pkgSuppressions are packages with ignore-package directive indexed by package path.
+		pkgSuppressions map[string]*suppression
+		// rootPath is a directory of go.mod or go.work file, printed paths are relative to it when possible.
+		rootPath string
+		// packages are paths of all analyzed packages, which are matching the filter.
+		packages map[string]struct{}
+		// duration is a time spent by computing dead code of the entrypoint.
+		duration time.Duration
+		// whyLive explains reachability of WhyLiveFlag function from the entrypoint.
+		whyLive *whyLiveResult
+		// fileDecls are declarations of files matching the filter, indexed by Position.File.
+		// They are collected only with DeadFilesFlag.
+		fileDecls map[string]*fileDecls
+	}
+
+	scanPlan struct {
+		path   string
+		config BuildConfig
+		custom bool
 	}
 
 	deadPackageFuncs struct {
-		pkg   *Package
+		pkg *Package
+		// funcs are indexed by funcKey, so functions with the same name from different files are distinguished.
 		funcs map[string]*Function
 	}
 )
 
+// OutputFormats are all supported output formats.
+var OutputFormats = []string{
+	"text", "json", "jsonl", "sarif", "github", "gitlab", "checkstyle", "junit", "markdown", "rdjson", "rdjsonl",
+	"csv",
+}
+
 // New creates runner for analysis.
 // Pass paths to all Go main files within monorepo. If you pass only 1 path, it will behave like normal deadcode.
 func New(writer, errWriter io.Writer, paths []string) *Runner {
@@ -60,6 +159,8 @@ func New(writer, errWriter io.Writer, paths []string) *Runner {
 }
 
 func (r *Runner) writeStderr(format string, args ...any) {
+	r.errMu.Lock()
+	defer r.errMu.Unlock()
 	fmt.Fprintf(r.errWriter, strings.TrimSuffix(format, "\n")+"\n", args...)
 }
 
@@ -69,72 +170,203 @@ func (r *Runner) writeDebug(format string, args ...any) {
 	}
 }
 
+// hasOutputFormat reports whether the format is printed to stdout or written into any of Outputs.
+func (r *Runner) hasOutputFormat(format string) bool {
+	return r.format() == format || slices.ContainsFunc(r.Outputs, func(o Output) bool { return o.Format == format })
+}
+
 // Run the deadcode analysis across monorepo and prints out unused exported functions.
 func (r *Runner) Run(ctx context.Context) error {
 	if len(r.paths) == 0 {
 		return fmt.Errorf("no paths provided")
 	}
+	if !slices.Contains(OutputFormats, r.format()) {
+		return fmt.Errorf("unknown output format '%s', expected one of: %s", r.format(), strings.Join(OutputFormats, ", "))
+	}
+	for _, o := range r.Outputs {
+		if !slices.Contains(OutputFormats, o.Format) {
+			return fmt.Errorf("unknown output format '%s' of %s, expected one of: %s",
+				o.Format, o.Path, strings.Join(OutputFormats, ", "))
+		}
+	}
+	if err := r.compileTemplate(); err != nil {
+		return err
+	}
+	if strings.Contains(r.SourceURLFlag, "{commit}") && r.CommitFlag == "" && r.hasOutputFormat("markdown") {
+		return fmt.Errorf("source URL template '%s' contains {commit}, but no commit is provided", r.SourceURLFlag)
+	}
+	if _, _, ok := splitQualifiedName(r.WhyLiveFlag); r.WhyLiveFlag != "" && !ok {
+		return fmt.Errorf("invalid whylive function '%s', expected example.com/pkg.Func or example.com/pkg.Type.Method",
+			r.WhyLiveFlag)
+	}
 	err := r.verifyBinaries(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Collect as much information as possible about entrypoints before we start scanning for deadcode.
-	eps := make([]*entrypointInfo, 0, len(r.paths))
-	for _, path := range r.paths {
-		var ep *entrypointInfo
-		ep, err = r.scanEntrypoint(ctx, path)
+	// Each entrypoint is scanned once per build configuration.
+	// Results are stored by index, so the order of entrypoints doesn't depend on completion order.
+	scans, err := r.planScans()
+	if err != nil {
+		return err
+	}
+	ignore, err := r.compileIgnore()
+	if err != nil {
+		return err
+	}
+	eps := make([]*entrypointInfo, len(scans))
+	g, gctx := r.newErrGroup(ctx)
+	for i, scan := range scans {
+		g.Go(func() error {
+			ep, err := r.scanEntrypoint(gctx, scan)
+			if err != nil {
+				return err
+			}
+			eps[i] = ep
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return err
+	}
+	for _, ep := range eps {
+		if err = r.verifyModule(ep); err != nil {
+			return err
+		}
+	}
+	if r.workspaceDir != "" {
+		r.workspaceModules, err = r.listWorkspaceModules(ctx)
 		if err != nil {
 			return err
 		}
-		eps = append(eps, ep)
+	} else if r.FilterFlag == "<workspace>" {
+		return fmt.Errorf("workspace filter requires all entrypoints within the same go.work workspace")
 	}
 
 	// Scan for deadcode.
-	for _, ep := range eps {
-		ep.deadCode, err = r.listEntrypointDeadCode(ctx, ep.absPath)
+	err = r.analyzeEntrypoints(ctx, eps)
+	if err != nil {
+		return err
+	}
+	if r.hasCommonModule || r.workspaceDir != "" {
+		r.rootPath = eps[0].rootPath
+	}
+	if r.WhyLiveFlag != "" {
+		return r.printWhyLive(eps)
+	}
+	if r.AttributionFlag {
+		r.printAttribution(r.intersectBuildConfigs(eps))
+		return nil
+	}
+
+	deadCode := r.intersectDeadCode(r.intersectBuildConfigs(eps))
+	// Suppressions are applied before ignore patterns, so functions matching both are not reported as unnecessary.
+	suppressed, unnecessary := applySuppressions(eps, deadCode)
+	removeIgnored(deadCode, ignore)
+
+	// New baseline records all current findings, so it is written before the previous baseline is applied.
+	if r.WriteBaselineFlag != "" {
+		if err = r.writeBaseline(deadCode); err != nil {
+			return err
+		}
+	}
+	if r.BaselineFlag != "" {
+		if err = r.applyBaseline(deadCode); err != nil {
+			return err
+		}
+	}
+
+	if err = r.print(ctx, r.writer, r.format(), eps, deadCode); err != nil {
+		return err
+	}
+	if r.UnusedPackagesFlag {
+		unused, err := r.listUnusedPackages(ctx, eps, ignore)
 		if err != nil {
 			return err
 		}
+		r.printUnusedPackages(unused)
+	}
+	if r.DeadFilesFlag {
+		r.printDeadFiles(deadFiles(eps, deadCode))
+	}
+	for _, o := range r.Outputs {
+		if err = r.writeOutput(ctx, o, eps, deadCode); err != nil {
+			return err
+		}
+	}
+	if r.HTMLFlag != "" {
+		if err = r.writeHTML(r.intersectBuildConfigs(eps), deadCode); err != nil {
+			return err
+		}
 	}
 
-	deadCode := r.intersectDeadCode(eps)
+	return r.printSuppressions(suppressed, unnecessary)
+}
 
-	if r.JSONFlag {
-		return r.printJSON(ctx, deadCode)
+// planScans returns all combinations of entrypoints and their build configurations to be scanned.
+// Entrypoint with own build settings (see ParseEntrypoint) is scanned only with them, others with Matrix.
+func (r *Runner) planScans() ([]scanPlan, error) {
+	configs := r.Matrix
+	if len(configs) == 0 {
+		configs = []BuildConfig{{}}
 	}
-	r.printText(ctx, deadCode)
 
-	return nil
+	scans := make([]scanPlan, 0, len(r.paths)*len(configs))
+	for _, arg := range r.paths {
+		path, config, err := ParseEntrypoint(arg)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			scans = append(scans, scanPlan{path: path, config: *config, custom: true})
+			continue
+		}
+		for _, config := range configs {
+			scans = append(scans, scanPlan{path: path, config: config})
+		}
+	}
+	return scans, nil
 }
 
-func (r *Runner) scanEntrypoint(ctx context.Context, path string) (*entrypointInfo, error) {
+func (r *Runner) scanEntrypoint(ctx context.Context, scan scanPlan) (*entrypointInfo, error) {
+	path, config := scan.path, scan.config
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert '%s' to absolute path: %w", path, err)
 	}
-	r.writeDebug("Start scanning entrypoint: %s", absPath)
+	if len(r.Matrix) > 0 || scan.custom {
+		r.writeDebug("Start scanning entrypoint: %s with build configuration %s", absPath, config)
+	} else {
+		r.writeDebug("Start scanning entrypoint: %s", absPath)
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	err = r.verifyModule(ctx, absPath)
+	// Module and workspace are read from go.mod and go.work files, dependencies are taken from loaded packages later,
+	// so no `go` command is run per entrypoint.
+	workspace, err := r.detectWorkspace(absPath)
 	if err != nil {
 		return nil, err
 	}
 
-	ep, err := r.listDependencies(ctx, absPath)
+	module, err := r.readModule(absPath)
 	if err != nil {
 		return nil, err
 	}
 
+	ep := &entrypointInfo{absPath: absPath}
+	ep.module = module
+	ep.workspace = workspace
+	ep.config = config
+
 	return ep, nil
 }
 
 func (r *Runner) verifyBinaries(_ context.Context) error {
-	_, err := exec.LookPath("deadcode")
-	if err != nil {
-		r.writeStderr("Install deadcode with 'go install golang.org/x/tools/cmd/deadcode@latest'")
-		return err
-	}
-	_, err = exec.LookPath("go")
+	_, err := exec.LookPath("go")
 	if err != nil {
 		r.writeStderr("Go is not in $PATH")
 		return err
@@ -142,171 +374,347 @@ func (r *Runner) verifyBinaries(_ context.Context) error {
 	return nil
 }
 
-func (r *Runner) verifyModule(ctx context.Context, absPath string) error {
-	// Verify module name
-	out, err := getCommandOutput(ctx, filepath.Dir(absPath), "go", "list", "-m")
+// newErrGroup returns errgroup limited to JobsFlag concurrently running goroutines.
+// The returned context is canceled by the first failing goroutine, so others stop as soon as possible.
+func (r *Runner) newErrGroup(ctx context.Context) (*errgroup.Group, context.Context) {
+	g, gctx := errgroup.WithContext(ctx)
+	jobs := r.JobsFlag
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	g.SetLimit(jobs)
+	return g, gctx
+}
+
+// detectWorkspace returns directory of go.work file used for the entrypoint, empty if it is not within a workspace.
+// Same as `go env GOWORK`, GOWORK environment variable takes precedence over searching parent directories.
+func (r *Runner) detectWorkspace(absPath string) (string, error) {
+	goWork := os.Getenv("GOWORK")
+	switch goWork {
+	case "off":
+		return "", nil
+	case "":
+		file, err := findParentFile(filepath.Dir(absPath), "go.work")
+		if err != nil || file == "" {
+			return "", err
+		}
+		goWork = file
+	default:
+		abs, err := filepath.Abs(goWork)
+		if err != nil {
+			return "", fmt.Errorf("failed to detect workspace: %w", err)
+		}
+		goWork = abs
+	}
+	r.writeDebug("Detected workspace: %s", goWork)
+	return filepath.Dir(goWork), nil
+}
+
+// readModule returns path of the module the entrypoint belongs to, as declared in the nearest go.mod file.
+func (r *Runner) readModule(absPath string) (string, error) {
+	file, err := findParentFile(filepath.Dir(absPath), "go.mod")
 	if err != nil {
-		return fmt.Errorf("failed to list module name: %w", err)
+		return "", err
 	}
+	if file == "" {
+		return "", fmt.Errorf("failed to find module of %s: go.mod file not found in any parent directory", absPath)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read module name: %w", err)
+	}
+	module := strings.TrimSuffix(modfile.ModulePath(data), "/")
+	if module == "" {
+		return "", fmt.Errorf("failed to read module name: no module directive in %s", file)
+	}
+	r.writeDebug("Detected module name: %s/", module)
+	return module, nil
+}
 
-	m := strings.TrimSuffix(strings.TrimSpace(string(out)), "/") + "/"
-	switch {
-	case r.commonModule == "":
+// verifyModule checks module of the entrypoint against the modules of already verified entrypoints.
+// Must be called sequentially in the order of entrypoints.
+func (r *Runner) verifyModule(ep *entrypointInfo) error {
+	m := ep.module + "/"
+	if r.commonModule == "" {
 		r.commonModule = m
 		r.hasCommonModule = true
+		r.workspaceDir = ep.workspace
+		return nil
+	}
+	if r.workspaceDir != ep.workspace {
+		// Paths can be relative to go.work only if all entrypoints are within the same workspace.
+		r.workspaceDir = ""
+	}
+
+	switch {
 	case r.commonModule == m:
 		// Do nothing, as everything was set before
-	case r.FilterFlag == "<module>" || r.FilterFlag == "":
+	case r.workspaceDir != "":
+		// Different modules within the same workspace are supported, all workspace modules are in scope.
+		r.hasCommonModule = false
+	case r.isDefaultFilter():
 		// If we have custom filter, we don't need to have same module, as we will filter by regexp anyway.
 		// If flag is <module>, we need all scans to be within same module, otherwise intersection will be empty.
 		return fmt.Errorf("different modules are not supported without filter flag: %s != %s", r.commonModule, m)
 	default:
 		r.hasCommonModule = false
 	}
-	r.writeDebug("Detected module name: %s", r.commonModule)
 	return nil
 }
 
-func (r *Runner) listDependencies(ctx context.Context, absPath string) (*entrypointInfo, error) {
-	out, err := getCommandOutput(ctx, filepath.Dir(absPath), "go", "list", "-f", `{{range .Deps}}{{.}}{{"\n"}}{{end}}`)
+func (r *Runner) listWorkspaceModules(ctx context.Context) ([]string, error) {
+	out, err := getCommandStdout(ctx, r.workspaceDir, "go", "list", "-m", "-f", "{{.Path}}")
 	if err != nil {
-		return nil, fmt.Errorf("failed to list dependencies: %w", err)
-	}
-
-	ep := &entrypointInfo{
-		absPath: absPath,
-		deps:    make(map[string]struct{}),
+		return nil, fmt.Errorf("failed to list workspace modules: %w", err)
 	}
+	modules := make([]string, 0)
 	for _, line := range strings.Split(string(out), "\n") {
-		if line == "" {
-			continue
+		if line != "" {
+			modules = append(modules, line)
 		}
-		ep.deps[line] = struct{}{}
 	}
-	r.writeDebug("Detected %d dependencies", len(ep.deps))
-	return ep, nil
+	r.writeDebug("Detected %d workspace modules", len(modules))
+	return modules, nil
 }
 
-func (r *Runner) getDeadCodeArgs() []string {
-	args := []string{"-json"}
-	if r.GeneratedFlag {
-		args = append(args, "-generated")
-	}
-	if r.TestFlag {
-		args = append(args, "-test")
+// isDefaultFilter reports whether the filter is derived from the module or workspace of entrypoints.
+func (r *Runner) isDefaultFilter() bool {
+	return r.FilterFlag == "" || r.FilterFlag == "<module>" || r.FilterFlag == "<workspace>"
+}
+
+func (r *Runner) compileIgnore() ([]*regexp.Regexp, error) {
+	ignore := make([]*regexp.Regexp, 0, len(r.Ignore))
+	for _, pattern := range r.Ignore {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern: %w", err)
+		}
+		ignore = append(ignore, re)
 	}
-	if r.TagsFlag != "" {
-		args = append(args, "-tags", r.TagsFlag)
+	return ignore, nil
+}
+
+// removeIgnored removes functions matching any of ignore patterns by fully qualified name or file path.
+func removeIgnored(deadCode map[string]deadPackageFuncs, ignore []*regexp.Regexp) {
+	if len(ignore) == 0 {
+		return
 	}
-	if r.FilterFlag != "" {
-		args = append(args, "-filter", r.FilterFlag)
+	for _, dpf := range deadCode {
+		for key, fun := range dpf.funcs {
+			qualifiedName := dpf.pkg.Path + "." + fun.Name
+			for _, re := range ignore {
+				if re.MatchString(qualifiedName) || re.MatchString(fun.Position.File) {
+					delete(dpf.funcs, key)
+					break
+				}
+			}
+		}
 	}
-	return append(args, "./...")
 }
 
-func (r *Runner) listEntrypointDeadCode(ctx context.Context, absPath string) (map[string]deadPackageFuncs, error) {
-	absDirPath := filepath.Dir(absPath)
-	out, err := getCommandOutput(ctx, absDirPath, "go", "list", "-f", `{{.Root}}`)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list root path: %w", err)
+// intersectBuildConfigs merges scans of the same entrypoint with different build configurations.
+// A function is kept only if it is dead in every configuration that compiles its file.
+// Configurations not compiling the file have no opinion about it, like entrypoints not importing the package.
+func (*Runner) intersectBuildConfigs(eps []*entrypointInfo) []*entrypointInfo {
+	byPath := make(map[string][]*entrypointInfo)
+	result := make([]*entrypointInfo, 0)
+	for _, ep := range eps {
+		if _, found := byPath[ep.absPath]; !found {
+			result = append(result, ep)
+		}
+		byPath[ep.absPath] = append(byPath[ep.absPath], ep)
 	}
-	rootPath := filepath.Clean(strings.TrimSpace(string(out))) + string(filepath.Separator)
-	r.writeDebug("Detected root path: %s", rootPath)
-
-	r.writeDebug("Starting to scan %s for deadcode, might take a while", absDirPath)
-	timeStart := time.Now()
 
-	out, err = getCommandOutput(ctx, absDirPath, "deadcode", r.getDeadCodeArgs()...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list deadcode: %w", err)
-	}
-	r.writeDebug("Scanning %s for deadcode finished in %s", absDirPath, time.Since(timeStart))
+	for i, first := range result {
+		scans := byPath[first.absPath]
+		if len(scans) == 1 {
+			continue
+		}
 
-	deadCode := map[string]deadPackageFuncs{}
-	pkgs := make([]*Package, 0)
-	if err := json.Unmarshal(out, &pkgs); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal deadcode output: %w", err)
-	}
-	for _, pkg := range pkgs {
-		dpf := deadPackageFuncs{
-			pkg:   pkg,
-			funcs: make(map[string]*Function),
+		merged := &entrypointInfo{
+			absPath:   first.absPath,
+			module:    first.module,
+			workspace: first.workspace,
+			rootPath:  first.rootPath,
+			deps:      make(map[string]struct{}),
+			deadCode:  make(map[string]deadPackageFuncs),
+			liveCode:  make(map[string]deadPackageFuncs),
+			files:     make(map[string]struct{}),
 		}
-		for _, fun := range pkg.Funcs {
-			f := fun.Position.File
-			// If path is not absolute, it means it is relative to the module root.
-			// Because we have many entrypoints, we need to make sure we have absolute paths.
-			if !filepath.IsAbs(f) {
-				f = filepath.Join(absDirPath, f)
+		for _, scan := range scans {
+			maps.Copy(merged.deps, scan.deps)
+			maps.Copy(merged.files, scan.files)
+
+			// Function live in any configuration is live.
+			for pkg, dpf := range scan.liveCode {
+				mergedDpf, found := merged.liveCode[pkg]
+				if !found {
+					mergedDpf = deadPackageFuncs{pkg: dpf.pkg, funcs: make(map[string]*Function)}
+					merged.liveCode[pkg] = mergedDpf
+				}
+				maps.Copy(mergedDpf.funcs, dpf.funcs)
 			}
-			// If all entrypoints are within same module, we can remove the path to module root from the file path.
-			// Then it will be relative to go.mod file.
-			if r.hasCommonModule {
-				f, _ = strings.CutPrefix(f, rootPath)
+
+			for pkg, dpf := range scan.deadCode {
+				for key, fun := range dpf.funcs {
+					if !isDeadInAllScans(scans, pkg, key, fun) {
+						continue
+					}
+					mergedDpf, found := merged.deadCode[pkg]
+					if !found {
+						mergedDpf = deadPackageFuncs{pkg: dpf.pkg, funcs: make(map[string]*Function)}
+						merged.deadCode[pkg] = mergedDpf
+					}
+					mergedDpf.funcs[key] = fun
+				}
 			}
-			fun.Position.File = f // Override back, so we have consistent output.
-			dpf.funcs[fun.Name] = fun
 		}
-		pkg.Funcs = nil // Clear just not to use it accidentally.
-		deadCode[pkg.Path] = dpf
+		result[i] = merged
 	}
-
-	return deadCode, nil
+	return result
 }
 
-func (*Runner) intersectDeadCode(eps []*entrypointInfo) map[string]deadPackageFuncs {
-	result := eps[0]
-	for pkg := range result.deps {
-		if _, found := result.deadCode[pkg]; !found {
-			// If pkg is using all functions inside (has no records in deadcode), we should mark that to result.
-			// This is special case, but we need to keep track of it.
-			result.deps[pkg] = struct{}{}
-			result.deadCode[pkg] = deadPackageFuncs{}
+func isDeadInAllScans(scans []*entrypointInfo, pkg, key string, fun *Function) bool {
+	for _, scan := range scans {
+		if _, compiled := scan.files[fun.Position.File]; !compiled {
+			continue
+		}
+		if _, dead := scan.deadCode[pkg].funcs[key]; !dead {
+			return false
 		}
 	}
+	return true
+}
 
-	for _, ep := range eps[1:] {
+// intersectDeadCode keeps only functions dead in all entrypoints compiling their file.
+// Entrypoints which don't import the package (or don't compile the file due to build constraints) have no opinion.
+// Entrypoint importing the package without any dead function in it marks all functions of the package as live.
+func (*Runner) intersectDeadCode(eps []*entrypointInfo) map[string]deadPackageFuncs {
+	result := make(map[string]deadPackageFuncs)
+	for _, ep := range eps {
 		for pkg, dpf := range ep.deadCode {
-			// Iterating entrypoint uses package, that wasn't scanned yet,
-			// just add all what is unused in iterating entrypoint.
-			_, visitedDep := result.deps[pkg]
-			// Check deadcode in result already, if not exists too, same situation as above
-			resultDpf, exists := result.deadCode[pkg]
-
-			if !exists || !visitedDep {
-				result.deps[pkg] = struct{}{}
-				result.deadCode[pkg] = dpf
-				continue
-			}
-
-			// Package was already scanned, so we need to intersect the deadcode.
-			// Only keep functions that appear in both maps.
-			intersection := deadPackageFuncs{
-				pkg:   resultDpf.pkg,
-				funcs: make(map[string]*Function),
-			}
-			for fnName := range resultDpf.funcs {
-				// If what we consider unused so far exists in current entrypoint too, keep it.
-				if fun, found := dpf.funcs[fnName]; found {
-					intersection.funcs[fnName] = fun
+			for key, fun := range dpf.funcs {
+				if _, done := result[pkg].funcs[key]; done {
+					continue
+				}
+				if !isDeadInAllScans(eps, pkg, key, fun) {
+					continue
 				}
+				resultDpf, found := result[pkg]
+				if !found {
+					resultDpf = deadPackageFuncs{pkg: dpf.pkg, funcs: make(map[string]*Function)}
+					result[pkg] = resultDpf
+				}
+				resultDpf.funcs[key] = fun
 			}
-			result.deadCode[pkg] = intersection
 		}
+	}
+	return result
+}
 
-		for pkg := range ep.deps {
-			// If our pkg is using all functions inside (has no records in deadcode), we should mark that to result.
-			// This is special case, but we need to keep track of it.
-			if len(ep.deadCode[pkg].funcs) == 0 {
-				result.deps[pkg] = struct{}{}
-				result.deadCode[pkg] = deadPackageFuncs{}
-			}
+// format returns output format, JSONFlag takes precedence for backward compatibility.
+func (r *Runner) format() string {
+	switch {
+	case r.JSONFlag:
+		return "json"
+	case r.FormatFlag == "":
+		return "text"
+	default:
+		return r.FormatFlag
+	}
+}
+
+// print prints dead code in the output format into the writer.
+func (r *Runner) print(
+	ctx context.Context, w io.Writer, format string, eps []*entrypointInfo, deadCode map[string]deadPackageFuncs,
+) error {
+	switch format {
+	case "json":
+		return r.printJSON(ctx, w, deadCode)
+	case "jsonl":
+		return r.printJSONL(ctx, w, deadCode)
+	case "sarif":
+		return r.printSARIF(ctx, w, deadCode)
+	case "github":
+		r.printGitHub(ctx, w, deadCode)
+		return nil
+	case "gitlab":
+		return r.printGitLab(ctx, w, deadCode)
+	case "checkstyle":
+		return r.printCheckstyle(ctx, w, deadCode)
+	case "junit":
+		return r.printJUnit(ctx, w, eps, deadCode)
+	case "markdown":
+		r.printMarkdown(ctx, w, deadCode)
+		return nil
+	case "rdjson":
+		return r.printRDJSON(ctx, w, deadCode, false)
+	case "rdjsonl":
+		return r.printRDJSON(ctx, w, deadCode, true)
+	case "csv":
+		return r.printCSV(ctx, w, eps, deadCode)
+	default:
+		if r.template != nil {
+			return r.printTemplate(ctx, w, deadCode)
 		}
+		r.printText(ctx, w, deadCode)
+		return nil
 	}
-	return result.deadCode
 }
 
-func (r *Runner) printJSON(_ context.Context, deadCode map[string]deadPackageFuncs) error {
+// entrypointName returns path of the entrypoint relative to the root, if paths are relative.
+func (r *Runner) entrypointName(ep *entrypointInfo) string {
+	name, _ := strings.CutPrefix(ep.absPath, r.rootPath)
+	return name
+}
+
+// scanName returns name of the entrypoint with its build configuration, if it is not the current environment.
+func (r *Runner) scanName(ep *entrypointInfo) string {
+	name := r.entrypointName(ep)
+	if config := ep.config.String(); config != "/" {
+		name += " (" + config + ")"
+	}
+	return name
+}
+
+// checkoutPath returns path of the file relative to the repository checkout in checkoutEnv environment variable.
+// Printed paths are relative to go.mod or go.work, which doesn't have to be in the root of the repository.
+func (r *Runner) checkoutPath(checkoutEnv, file string) string {
+	workspace := os.Getenv(checkoutEnv)
+	if workspace == "" {
+		return file
+	}
+	if !filepath.IsAbs(file) {
+		if r.rootPath == "" {
+			return file
+		}
+		file = filepath.Join(r.rootPath, file)
+	}
+	rel, err := filepath.Rel(workspace, file)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		// File is outside of the repository checkout, like a dependency in module cache.
+		return file
+	}
+	return filepath.ToSlash(rel)
+}
+
+// ciCheckoutPath returns path of the file relative to the checkout of GitHub Actions or GitLab CI,
+// whichever is set, or the printed path otherwise.
+func (r *Runner) ciCheckoutPath(file string) string {
+	if os.Getenv("GITHUB_WORKSPACE") != "" {
+		return r.checkoutPath("GITHUB_WORKSPACE", file)
+	}
+	return r.checkoutPath("CI_PROJECT_DIR", file)
+}
+
+func (r *Runner) printJSON(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(sortedPackages(deadCode))
+}
+
+// sortedPackages returns packages with at least one dead function, sorted by path, functions by position.
+func sortedPackages(deadCode map[string]deadPackageFuncs) []*Package {
 	out := make([]*Package, 0, len(deadCode))
 
 	for _, dpf := range deadCode {
@@ -330,25 +738,19 @@ func (r *Runner) printJSON(_ context.Context, deadCode map[string]deadPackageFun
 	slices.SortFunc(out, func(a, b *Package) int {
 		return strings.Compare(a.Path, b.Path)
 	})
-
-	enc := json.NewEncoder(r.writer)
-	enc.SetIndent("", "\t")
-	return enc.Encode(out)
+	return out
 }
 
-func (r *Runner) printText(_ context.Context, deadCode map[string]deadPackageFuncs) {
+func (r *Runner) printText(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) {
 	allPaths := make([]string, 0)
 	for _, dpf := range deadCode {
 		for _, fun := range dpf.funcs {
-			allPaths = append(allPaths, fmt.Sprintf(
-				"%s:%d:%d: unreachable func: %s",
-				fun.Position.File, fun.Position.Line, fun.Position.Col, fun.Name,
-			))
+			allPaths = append(allPaths, fmt.Sprintf("%s: unreachable func: %s", fun.Position, fun.Name))
 		}
 	}
 
 	slices.Sort(allPaths)
 	for _, path := range allPaths {
-		fmt.Fprintln(r.writer, path)
+		fmt.Fprintln(w, path)
 	}
 }
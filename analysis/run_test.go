@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -38,7 +40,7 @@ var _ = Describe("Runner", func() {
 		r := analysis.New(stdOut, stdErr, []string{"/home"})
 		err := r.Run(ctx)
 		Expect(err).To(MatchError(ContainSubstring(
-			"failed to list dependencies: go: go.mod file not found in current directory or any parent directory",
+			"failed to find module of /home: go.mod file not found in any parent directory",
 		)))
 	})
 
@@ -59,11 +61,19 @@ var _ = Describe("Runner", func() {
 		r.FilterFlag = "*"
 		err := r.Run(ctx)
 		Expect(err).To(MatchError(HavePrefix(
-			//nolint:dupword // no duplicate word, but real error
-			"failed to list deadcode: deadcode: -filter: error parsing regexp: missing argument to repetition operator",
+			"invalid filter flag: error parsing regexp: missing argument to repetition operator",
 		)))
 	})
 
+	It("Skips marker methods", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/config/main.go"})
+		r.FilterFlag = "/pkg/cache"
+		Expect(r.Run(ctx)).To(Succeed())
+		// Method item.isEntry is unreachable, but it restricts types implementing cache.Entry.
+		Expect(stdOut.String()).To(Equal("analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete\n"))
+	})
+
 	DescribeTable("Verify all in one example",
 		func(paths []string) {
 			ctx := context.Background()
@@ -103,6 +113,34 @@ var _ = Describe("Runner", func() {
 		}),
 	)
 
+	It("Produces same output regardless of number of jobs", func() {
+		ctx := context.Background()
+		paths := []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		}
+
+		r := analysis.New(stdOut, stdErr, paths)
+		r.JobsFlag = 1
+		Expect(r.Run(ctx)).To(Succeed())
+		sequential := stdOut.String()
+
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, paths)
+		r.JobsFlag = len(paths)
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(sequential))
+	})
+
+	It("Stops on canceled context", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		Expect(r.Run(ctx)).To(MatchError(ContainSubstring("context canceled")))
+	})
+
 	It("Verify debug output", func() {
 		ctx := context.Background()
 		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
@@ -114,10 +152,19 @@ var _ = Describe("Runner", func() {
 		dir := filepath.Dir(absPath) + "/"
 		Expect(stdErr.String()).To(HavePrefix(
 			"Start scanning entrypoint: " + dir + "analysis/testdata/allinone/services/authn/main.go\n" +
-				"Detected module name: github.com/arxeiss/deadmono/\n" +
-				"Detected 30 dependencies\n" +
-				"Detected root path: " + dir + "\n" +
-				"Starting to scan " + dir + "analysis/testdata/allinone/services/authn for deadcode, might take a while\n" +
+				"Detected module name: github.com/arxeiss/deadmono/\n",
+		))
+		// Number of dependencies depends on Go version, as standard library is included.
+		Expect(stdErr.String()).To(MatchRegexp(
+			`\nDetected \d+ dependencies of ` + regexp.QuoteMeta(dir+"analysis/testdata/allinone/services/authn/main.go") + `\n`,
+		))
+		Expect(stdErr.String()).To(ContainSubstring(
+			"Starting to load 1 entrypoint(s) of module github.com/arxeiss/deadmono, might take a while\n" +
+				"Loading module github.com/arxeiss/deadmono finished in ",
+		))
+		Expect(stdErr.String()).To(ContainSubstring(
+			"Detected root path: " + dir + "\n" +
+				"Starting to scan " + dir + "analysis/testdata/allinone/services/authn for deadcode\n" +
 				"Scanning " + dir + "analysis/testdata/allinone/services/authn for deadcode finished in ",
 		))
 	})
@@ -155,6 +202,155 @@ var _ = Describe("Runner", func() {
 			"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n"),
 	)
 
+	It("Intersects build configuration matrix", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.Matrix = []analysis.BuildConfig{
+			{GOOS: "linux", GOARCH: "amd64"},
+			{GOOS: "linux", GOARCH: "arm64", Tags: "rpi"},
+			{GOOS: "windows", GOARCH: "amd64"},
+		}
+		Expect(r.Run(ctx)).To(Succeed())
+
+		// http.Get is dead only without rpi tag, RunFromTest is in file compiled only without rpi tag.
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/http/http.go:17:6: unreachable func: Put\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n" +
+				"analysis/testdata/allinone/services/authn/internal/auth.go:18:6: unreachable func: RunFromTest\n",
+		))
+	})
+
+	It("Intersects build configuration matrix across entrypoints", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.Matrix = []analysis.BuildConfig{{}, {Tags: "rpi"}}
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n" +
+				"analysis/testdata/allinone/services/authn/internal/auth.go:18:6: unreachable func: RunFromTest\n",
+		))
+	})
+
+	It("Handles per-entrypoint build settings", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go@tags=rpi,goarch=arm64,cgo=0",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go@goos=windows",
+		})
+		r.DebugFlag = true
+		Expect(r.Run(ctx)).To(Succeed())
+
+		// Authn is built only with rpi tag, so auth.go is not compiled and RunFromTest is not reported.
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n",
+		))
+		Expect(stdErr.String()).To(ContainSubstring(
+			"analysis/testdata/allinone/services/authn/main.go with build configuration /arm64:rpi CGO_ENABLED=0\n",
+		))
+	})
+
+	It("Handles inline suppressions", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.ShowSuppressedFlag = true
+		r.CheckSuppressionsFlag = true
+		Expect(r.Run(ctx)).To(MatchError("found 1 unnecessary suppressions"))
+
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n" +
+				"analysis/testdata/allinone/services/authn/internal/auth.go:18:6: unreachable func: RunFromTest\n",
+		))
+		Expect(stdErr.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/legacy/legacy.go:9:6: suppressed unreachable func: Migrate " +
+				"(deprecated, will be removed)\n" +
+				"analysis/testdata/allinone/pkg/metrics/metrics.go:7:6: suppressed unreachable func: Dump " +
+				"(called from assembly)\n" +
+				"analysis/testdata/allinone/pkg/metrics/metrics.go:13:6: unnecessary suppression of live func: Observe\n",
+		))
+
+		By("Suppressing dead functions in generated files only when they are reported")
+		stdOut.Reset()
+		stdErr.Reset()
+		r.GeneratedFlag = true
+		Expect(r.Run(ctx)).To(MatchError("found 1 unnecessary suppressions"))
+		Expect(stdOut.String()).To(ContainSubstring("generated.go:5:6: unreachable func: Generated\n"))
+		Expect(stdErr.String()).To(ContainSubstring(
+			"analysis/testdata/allinone/services/authn/internal/generated.go:9:6: suppressed unreachable func: GeneratedHook " +
+				"(kept for compatibility of the generator)\n",
+		))
+	})
+
+	It("Reports only dead functions not in baseline", func() {
+		ctx := context.Background()
+		baseline := filepath.Join(GinkgoT().TempDir(), "baseline.json")
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.WriteBaselineFlag = baseline
+		Expect(r.Run(ctx)).To(Succeed())
+
+		data, err := os.ReadFile(baseline)
+		Expect(err).To(Succeed())
+		var recorded []*analysis.Package
+		Expect(json.Unmarshal(data, &recorded)).To(Succeed())
+		Expect(recorded).To(HaveLen(3))
+
+		By("Running single entrypoint with baseline")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.BaselineFlag = baseline
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/http/http.go:13:6: unreachable func: Post\n" +
+				"analysis/testdata/allinone/pkg/http/http.go:17:6: unreachable func: Put\n" +
+				"analysis/testdata/allinone/pkg/http/http.go:9:6: unreachable func: Get\n",
+		))
+	})
+
+	It("Matches baseline entries regardless of position", func() {
+		ctx := context.Background()
+		baseline := filepath.Join(GinkgoT().TempDir(), "baseline.json")
+		Expect(os.WriteFile(baseline, []byte(`[{
+			"Path": "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging",
+			"Funcs": [{"Name": "Debug", "Position": {"File": "moved.go", "Line": 100, "Col": 1}}]
+		}]`), 0o600)).To(Succeed())
+
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/config/main.go"})
+		r.BaselineFlag = baseline
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:3:6: unreachable func: New\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:9:6: unreachable func: Info\n",
+		))
+	})
+
+	It("Fails on missing baseline file", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.BaselineFlag = "testdata/nonexisting.json"
+		Expect(r.Run(ctx)).To(MatchError(HavePrefix("failed to read baseline file: ")))
+	})
+
 	It("Handles properly multiple modules with filter", func() {
 		ctx := context.Background()
 		r := analysis.New(stdOut, stdErr, []string{
@@ -178,6 +374,104 @@ var _ = Describe("Runner", func() {
 		Expect(foundMustParse).To(BeEmpty(), "MustParse should not be found")
 	})
 
+	It("Handles Go workspace", func() {
+		// Workspace mode doesn't allow -mod=mod, which might be set globally.
+		GinkgoT().Setenv("GOFLAGS", "")
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/workspace/services/api/main.go",
+			"testdata/workspace/services/worker/main.go",
+		})
+		// Fixture declares Newline per GOOS, so the output depends on the build configuration.
+		r.Matrix = []analysis.BuildConfig{{GOOS: "linux", GOARCH: "amd64"}}
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"services/worker/internal/worker.go:9:6: unreachable func: Retry\n" +
+				"shared/text/newline_linux.go:3:6: unreachable func: Newline\n" +
+				"shared/text/pad.go:5:6: unreachable func: PadLeft\n" +
+				"shared/text/pad.go:9:6: unreachable func: PadRight\n" +
+				"shared/text/style.go:8:16: unreachable func: Style.Apply\n" +
+				"shared/text/text.go:13:6: unreachable func: Title\n",
+		))
+	})
+
+	It("Reports unused packages", func() {
+		GinkgoT().Setenv("GOFLAGS", "")
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/workspace/services/api/main.go",
+			"testdata/workspace/services/worker/main.go",
+		})
+		r.Matrix = []analysis.BuildConfig{{GOOS: "linux", GOARCH: "amd64"}}
+		r.UnusedPackagesFlag = true
+		Expect(r.Run(ctx)).To(Succeed())
+		// Package testutil is imported only by tests, so it is not reported.
+		// Package unused is imported only by its own external test, which doesn't make it used.
+		Expect(stdOut.String()).To(Equal(
+			"services/worker/internal/worker.go:9:6: unreachable func: Retry\n" +
+				"shared/text/newline_linux.go:3:6: unreachable func: Newline\n" +
+				"shared/text/pad.go:5:6: unreachable func: PadLeft\n" +
+				"shared/text/pad.go:9:6: unreachable func: PadRight\n" +
+				"shared/text/style.go:8:16: unreachable func: Style.Apply\n" +
+				"shared/text/text.go:13:6: unreachable func: Title\n" +
+				"shared/unused: unreachable package: example.com/workspace/shared/unused (2 files)\n",
+		))
+
+		By("Printing to stderr with other formats")
+		stdOut.Reset()
+		r.FormatFlag = "json"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).NotTo(ContainSubstring("unreachable package"))
+		Expect(stdErr.String()).To(ContainSubstring(
+			"shared/unused: unreachable package: example.com/workspace/shared/unused (2 files)\n",
+		))
+
+		By("Skipping ignored packages")
+		stdOut.Reset()
+		r.FormatFlag = ""
+		r.Ignore = []string{"^shared/unused$"}
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).NotTo(ContainSubstring("unreachable package"))
+	})
+
+	It("Reports dead files", func() {
+		GinkgoT().Setenv("GOFLAGS", "")
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/workspace/services/api/main.go",
+			"testdata/workspace/services/worker/main.go",
+		})
+		r.Matrix = []analysis.BuildConfig{{GOOS: "linux", GOARCH: "amd64"}}
+		r.DeadFilesFlag = true
+		r.FormatFlag = "json"
+		Expect(r.Run(ctx)).To(Succeed())
+		// Files worker.go and text.go contain live functions, style.go declares type which might be used.
+		Expect(stdErr.String()).To(Equal(
+			"shared/text/newline_linux.go: unreachable file: all funcs are unreachable (1)\n" +
+				"shared/text/pad.go: unreachable file: all funcs are unreachable (2)\n" +
+				"shared/text/style.go: unreachable file: all funcs are unreachable (1), " +
+				"but it declares types or vars which might be used\n",
+		))
+
+		By("Skipping files with ignored functions")
+		stdErr.Reset()
+		r.Ignore = []string{"PadLeft$"}
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdErr.String()).NotTo(ContainSubstring("pad.go"))
+	})
+
+	It("fails on workspace filter without workspace", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FilterFlag = "<workspace>"
+		Expect(r.Run(ctx)).To(MatchError(
+			"workspace filter requires all entrypoints within the same go.work workspace",
+		))
+	})
+
 	It("Handles JSON output", func() {
 		ctx := context.Background()
 		r := analysis.New(stdOut, stdErr, []string{
@@ -292,4 +586,582 @@ var _ = Describe("Runner", func() {
 			"Name": Equal("MustParse"),
 		}))))
 	})
+
+	It("Handles JSON Lines output", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "jsonl"
+		r.GeneratedFlag = true
+		r.FilterFlag = "/services/authn/"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSuffix(stdOut.String(), "\n"), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(MatchJSON(`{
+			"Package": "github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal",
+			"PackageName": "internal",
+			"Name": "RunFromTest",
+			"Position": {"File": "analysis/testdata/allinone/services/authn/internal/auth.go", "Line": 18, "Col": 6},
+			"Generated": false,
+			"Marker": false
+		}`))
+		Expect(lines[1]).To(MatchJSON(`{
+			"Package": "github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal",
+			"PackageName": "internal",
+			"Name": "Generated",
+			"Position": {"File": "analysis/testdata/allinone/services/authn/internal/generated.go", "Line": 5, "Col": 6},
+			"Generated": true,
+			"Marker": false
+		}`))
+	})
+
+	It("Handles SARIF output", func() {
+		ctx := context.Background()
+		GinkgoT().Setenv("GITHUB_WORKSPACE", "")
+		pkg := "github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal"
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "sarif"
+		r.GeneratedFlag = true
+		r.FilterFlag = "/services/authn/"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		Expect(stdOut.String()).To(MatchJSON(`{
+			"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+			"version": "2.1.0",
+			"runs": [{
+				"tool": {"driver": {
+					"name": "deadmono",
+					"informationUri": "https://github.com/arxeiss/deadmono",
+					"rules": [
+						{
+							"id": "unreachable-func",
+							"shortDescription": {"text": "Function is unreachable from all entrypoints"},
+							"defaultConfiguration": {"level": "warning"}
+						},
+						{
+							"id": "unreachable-generated-func",
+							"shortDescription": {"text": "Function in generated Go file is unreachable from all entrypoints"},
+							"defaultConfiguration": {"level": "note"}
+						}
+					]
+				}},
+				"results": [
+					{
+						"ruleId": "unreachable-func",
+						"ruleIndex": 0,
+						"level": "warning",
+						"message": {"text": "unreachable func: RunFromTest"},
+						"locations": [{"physicalLocation": {
+							"artifactLocation": {"uri": "analysis/testdata/allinone/services/authn/internal/auth.go"},
+							"region": {"startLine": 18, "startColumn": 6}
+						}}],
+						"logicalLocations": [{
+							"fullyQualifiedName": "` + pkg + `.RunFromTest",
+							"kind": "function"
+						}]
+					},
+					{
+						"ruleId": "unreachable-generated-func",
+						"ruleIndex": 1,
+						"level": "note",
+						"message": {"text": "unreachable func: Generated"},
+						"locations": [{"physicalLocation": {
+							"artifactLocation": {"uri": "analysis/testdata/allinone/services/authn/internal/generated.go"},
+							"region": {"startLine": 5, "startColumn": 6}
+						}}],
+						"logicalLocations": [{
+							"fullyQualifiedName": "` + pkg + `.Generated",
+							"kind": "function"
+						}]
+					}
+				]
+			}]
+		}`))
+
+		By("Resolving paths against repository checkout")
+		absPath, err := filepath.Abs("..")
+		Expect(err).To(Succeed())
+		GinkgoT().Setenv("GITHUB_WORKSPACE", filepath.Dir(absPath))
+
+		stdOut.Reset()
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(ContainSubstring(
+			`"uri": "` + filepath.Base(absPath) + `/analysis/testdata/allinone/services/authn/internal/auth.go"`,
+		))
+	})
+
+	It("Handles GitHub Actions output", func() {
+		ctx := context.Background()
+		GinkgoT().Setenv("GITHUB_WORKSPACE", "")
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "github"
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"::warning file=analysis/testdata/allinone/pkg/logging/logging.go,line=6,col=6::unreachable func: Debug\n" +
+				"::warning file=analysis/testdata/allinone/pkg/logging/logging.go,line=12,col=6::unreachable func: Warn\n",
+		))
+
+		By("Resolving paths against repository checkout")
+		absPath, err := filepath.Abs("..")
+		Expect(err).To(Succeed())
+		GinkgoT().Setenv("GITHUB_WORKSPACE", filepath.Dir(absPath))
+
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "github"
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+		dir := filepath.Base(absPath)
+		Expect(stdOut.String()).To(Equal(
+			"::warning file=" + dir + "/analysis/testdata/allinone/pkg/logging/logging.go,line=6,col=6::" +
+				"unreachable func: Debug\n" +
+				"::warning file=" + dir + "/analysis/testdata/allinone/pkg/logging/logging.go,line=12,col=6::" +
+				"unreachable func: Warn\n",
+		))
+	})
+
+	It("Handles GitLab Code Quality output", func() {
+		ctx := context.Background()
+		GinkgoT().Setenv("CI_PROJECT_DIR", "")
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "gitlab"
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		var issues []map[string]any
+		Expect(json.Unmarshal(stdOut.Bytes(), &issues)).To(Succeed())
+		Expect(issues).To(HaveLen(2))
+		Expect(issues[0]).To(MatchAllKeys(Keys{
+			"description": Equal("unreachable func: Debug"),
+			"check_name":  Equal("unreachable-func"),
+			"fingerprint": MatchRegexp(`^[0-9a-f]{64}$`),
+			"severity":    Equal("minor"),
+			"location": MatchAllKeys(Keys{
+				"path":  Equal("analysis/testdata/allinone/pkg/logging/logging.go"),
+				"lines": MatchAllKeys(Keys{"begin": BeNumerically("==", 6)}),
+			}),
+		}))
+		Expect(issues[1]).To(HaveKeyWithValue("description", "unreachable func: Warn"))
+		Expect(issues[1]["fingerprint"]).NotTo(Equal(issues[0]["fingerprint"]))
+
+		By("Distinguishing the same function declared in files with different build constraints")
+		GinkgoT().Setenv("GOFLAGS", "")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/workspace/services/api/main.go"})
+		r.FormatFlag = "gitlab"
+		r.Matrix = []analysis.BuildConfig{{GOOS: "linux", GOARCH: "amd64"}, {GOOS: "windows", GOARCH: "amd64"}}
+		Expect(r.Run(ctx)).To(Succeed())
+
+		issues = nil
+		Expect(json.Unmarshal(stdOut.Bytes(), &issues)).To(Succeed())
+		newline := make(map[any]any)
+		for _, issue := range issues {
+			if issue["description"] == "unreachable func: Newline" {
+				newline[issue["fingerprint"]] = issue["location"].(map[string]any)["path"]
+			}
+		}
+		Expect(newline).To(ConsistOf("shared/text/newline_linux.go", "shared/text/newline_windows.go"))
+	})
+
+	It("Handles Checkstyle output", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "checkstyle"
+		r.GeneratedFlag = true
+		r.FilterFlag = "/pkg/logging|/services/authn/"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="5.0">
+	<file name="analysis/testdata/allinone/pkg/logging/logging.go">
+		<error line="6" column="6" severity="warning" message="unreachable func: Debug" ` +
+			`source="deadmono.unreachable-func"></error>
+		<error line="12" column="6" severity="warning" message="unreachable func: Warn" ` +
+			`source="deadmono.unreachable-func"></error>
+	</file>
+	<file name="analysis/testdata/allinone/services/authn/internal/auth.go">
+		<error line="18" column="6" severity="warning" message="unreachable func: RunFromTest" ` +
+			`source="deadmono.unreachable-func"></error>
+	</file>
+	<file name="analysis/testdata/allinone/services/authn/internal/generated.go">
+		<error line="5" column="6" severity="info" message="unreachable func: Generated" ` +
+			`source="deadmono.unreachable-generated-func"></error>
+	</file>
+</checkstyle>
+`))
+	})
+
+	It("Handles JUnit output", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.FormatFlag = "junit"
+		r.FilterFlag = "/pkg/(cache|http)"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		// Timing is not deterministic.
+		out := regexp.MustCompile(`time="\d+\.\d{3}"`).ReplaceAllString(stdOut.String(), `time="0.000"`)
+		Expect(out).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="deadmono" tests="2" failures="1" time="0.000">
+	<testsuite name="analysis/testdata/allinone/services/config/main.go" tests="1" failures="1" time="0.000">
+		<testcase name="github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/cache" ` +
+			`classname="analysis/testdata/allinone/services/config/main.go">
+			<failure message="1 unreachable funcs" type="unreachable-func"><![CDATA[` +
+			`analysis/testdata/allinone/pkg/cache/cache.go:12:6: unreachable func: Delete]]></failure>
+		</testcase>
+	</testsuite>
+	<testsuite name="analysis/testdata/allinone/services/healthcheck/main.go" tests="1" failures="0" time="0.000">
+		<testcase name="github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/http" ` +
+			`classname="analysis/testdata/allinone/services/healthcheck/main.go"></testcase>
+	</testsuite>
+</testsuites>
+`))
+	})
+
+	It("Writes HTML report", func() {
+		ctx := context.Background()
+		report := filepath.Join(GinkgoT().TempDir(), "report.html")
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.HTMLFlag = report
+		Expect(r.Run(ctx)).To(Succeed())
+
+		data, err := os.ReadFile(report)
+		Expect(err).To(Succeed())
+		html := string(data)
+		Expect(html).To(ContainSubstring("<p>4 unreachable funcs in 3 packages, analyzed entrypoints:</p>"))
+		Expect(html).To(ContainSubstring("<li><code>analysis/testdata/allinone/services/healthcheck/main.go</code></li>"))
+		Expect(html).To(ContainSubstring(
+			`<summary><span>github.com/arxeiss/deadmono/analysis/testdata/allinone</span> ` +
+				`<span class="count">4</span></summary>`,
+		))
+		Expect(html).To(ContainSubstring(
+			`<summary><span class="pkg">logging</span> <span class="count">2</span></summary>` + "\n" +
+				`<div class="entrypoints">Imported by: ` +
+				`<code>analysis/testdata/allinone/services/authn/main.go</code>, ` +
+				`<code>analysis/testdata/allinone/services/config/main.go</code>, ` +
+				`<code>analysis/testdata/allinone/services/healthcheck/main.go</code></div>`,
+		))
+		Expect(html).To(ContainSubstring(
+			`<span class="pos">analysis/testdata/allinone/pkg/cache/cache.go:12:6</span> unreachable func: <b>Delete</b>` +
+				"\n<pre>" +
+				`<span><span class="ln">10</span>}</span>` + "\n" +
+				`<span><span class="ln">11</span></span>` + "\n" +
+				`<span class="hl"><span class="ln">12</span>func Delete() {</span>` + "\n" +
+				`<span><span class="ln">13</span>}</span>` + "\n</pre>",
+		))
+	})
+
+	It("Handles Markdown output", func() {
+		GinkgoT().Setenv("GITHUB_WORKSPACE", "")
+		GinkgoT().Setenv("CI_PROJECT_DIR", "")
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "markdown"
+		r.FilterFlag = "/pkg/logging|/services/authn/"
+		r.SourceURLFlag = "https://github.com/arxeiss/deadmono/blob/{commit}/{path}#L{line}"
+		r.CommitFlag = "abc123"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal("## deadmono report\n\n" +
+			"Found **3** unreachable funcs in **2** packages.\n\n" +
+			"| Package | Unreachable funcs |\n" +
+			"| --- | ---: |\n" +
+			"| `github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging` | 2 |\n" +
+			"| `github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal` | 1 |\n" +
+			"\n<details>\n" +
+			"<summary><code>github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging</code> (2)</summary>\n\n" +
+			"- [`Debug`](https://github.com/arxeiss/deadmono/blob/abc123/analysis/testdata/allinone/pkg/logging/" +
+			"logging.go#L6) `analysis/testdata/allinone/pkg/logging/logging.go:6:6`\n" +
+			"- [`Warn`](https://github.com/arxeiss/deadmono/blob/abc123/analysis/testdata/allinone/pkg/logging/" +
+			"logging.go#L12) `analysis/testdata/allinone/pkg/logging/logging.go:12:6`\n" +
+			"\n</details>\n" +
+			"\n<details>\n" +
+			"<summary><code>github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal</code> (1)" +
+			"</summary>\n\n" +
+			"- [`RunFromTest`](https://github.com/arxeiss/deadmono/blob/abc123/analysis/testdata/allinone/services/authn/" +
+			"internal/auth.go#L18) `analysis/testdata/allinone/services/authn/internal/auth.go:18:6`\n" +
+			"\n</details>\n",
+		))
+
+		By("Linking paths relative to repository checkout")
+		absPath, err := filepath.Abs("testdata/allinone")
+		Expect(err).To(Succeed())
+		GinkgoT().Setenv("GITHUB_WORKSPACE", absPath)
+
+		stdOut.Reset()
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(ContainSubstring(
+			"- [`Debug`](https://github.com/arxeiss/deadmono/blob/abc123/pkg/logging/logging.go#L6) " +
+				"`analysis/testdata/allinone/pkg/logging/logging.go:6:6`\n",
+		))
+
+		By("Failing without commit substituted into source URL")
+		r.CommitFlag = ""
+		Expect(r.Run(ctx)).To(MatchError(
+			"source URL template 'https://github.com/arxeiss/deadmono/blob/{commit}/{path}#L{line}' contains {commit}, " +
+				"but no commit is provided",
+		))
+
+		By("Reporting no dead code")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "markdown"
+		r.FilterFlag = "/pkg/cache"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal("## deadmono report\n\nNo unreachable funcs found.\n"))
+	})
+
+	It("Handles template output", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FilterFlag = "/pkg/logging|/services/authn/"
+		r.TemplateFlag = `{{range .Funcs}}{{printf "%s: %s.%s\n" .Position $.Name .Name}}{{end}}`
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/logging/logging.go:6:6: logging.Debug\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:12:6: logging.Warn\n" +
+				"analysis/testdata/allinone/services/authn/internal/auth.go:18:6: internal.RunFromTest\n",
+		))
+
+		By("Executing template per function")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FilterFlag = "/pkg/logging"
+		r.TemplateFlag = `owner|{{.Package.Path}}|{{.Name}}|{{.Position.Line}}{{"\n"}}`
+		r.TemplatePerFuncFlag = true
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"owner|github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging|Debug|6\n" +
+				"owner|github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging|Warn|12\n",
+		))
+	})
+
+	It("fails on invalid template", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.TemplateFlag = "{{.Name"
+		Expect(r.Run(ctx)).To(MatchError(HavePrefix("invalid template: ")))
+
+		r.TemplateFlag = "{{.Name}}"
+		r.FormatFlag = "sarif"
+		Expect(r.Run(ctx)).To(MatchError("template cannot be combined with sarif output format"))
+	})
+
+	It("Handles reviewdog output", func() {
+		GinkgoT().Setenv("GITHUB_WORKSPACE", "")
+		GinkgoT().Setenv("CI_PROJECT_DIR", "")
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "rdjsonl"
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		debug := `{
+			"message": "unreachable func: Debug",
+			"location": {
+				"path": "analysis/testdata/allinone/pkg/logging/logging.go",
+				"range": {"start": {"line": 6, "column": 6}, "end": {"line": 6, "column": 11}}
+			},
+			"severity": "WARNING",
+			"source": {"name": "deadmono", "url": "https://github.com/arxeiss/deadmono"},
+			"code": {"value": "unreachable-func"}
+		}`
+		lines := strings.Split(strings.TrimSuffix(stdOut.String(), "\n"), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(MatchJSON(debug))
+
+		By("Printing single result")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "rdjson"
+		r.FilterFlag = "/pkg/logging"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		var result struct {
+			Source      json.RawMessage
+			Diagnostics []json.RawMessage
+		}
+		Expect(json.Unmarshal(stdOut.Bytes(), &result)).To(Succeed())
+		Expect(result.Source).To(MatchJSON(`{"name": "deadmono", "url": "https://github.com/arxeiss/deadmono"}`))
+		Expect(result.Diagnostics).To(HaveLen(2))
+		Expect(result.Diagnostics[0]).To(MatchJSON(debug))
+
+		By("Resolving paths against repository checkout")
+		absPath, err := filepath.Abs("testdata/allinone")
+		Expect(err).To(Succeed())
+		GinkgoT().Setenv("CI_PROJECT_DIR", absPath)
+
+		stdOut.Reset()
+		r.FormatFlag = "rdjsonl"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(HavePrefix(`{"message":"unreachable func: Debug",` +
+			`"location":{"path":"pkg/logging/logging.go",`))
+	})
+
+	It("Handles CSV output", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+		})
+		r.FormatFlag = "csv"
+		r.GeneratedFlag = true
+		r.FilterFlag = "/pkg/logging|/services/authn/"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"package_path,package_name,function,file,line,column,generated,marker,importing_entrypoints\n" +
+				"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging,logging,Debug," +
+				"analysis/testdata/allinone/pkg/logging/logging.go,6,6,false,false,2\n" +
+				"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging,logging,Warn," +
+				"analysis/testdata/allinone/pkg/logging/logging.go,12,6,false,false,2\n" +
+				"github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal,internal,RunFromTest," +
+				"analysis/testdata/allinone/services/authn/internal/auth.go,18,6,false,false,1\n" +
+				"github.com/arxeiss/deadmono/analysis/testdata/allinone/services/authn/internal,internal,Generated," +
+				"analysis/testdata/allinone/services/authn/internal/generated.go,5,6,true,false,1\n",
+		))
+	})
+
+	It("Explains why function is live", func() {
+		ctx := context.Background()
+		paths := []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		}
+		r := analysis.New(stdOut, stdErr, paths)
+		r.WhyLiveFlag = "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging.Error"
+		Expect(r.Run(ctx)).To(Succeed())
+
+		pkg := "github.com/arxeiss/deadmono/analysis/testdata/allinone/"
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/services/authn/main.go reaches " + pkg + "pkg/logging.Error:\n" +
+				"\t" + pkg + "services/authn.main\n" +
+				"\tstatic function call --> " + pkg + "services/authn/internal.Run\n" +
+				"\tstatic function call --> " + pkg + "pkg/logging.Error\n" +
+				"analysis/testdata/allinone/services/config/main.go reaches " + pkg + "pkg/logging.Error:\n" +
+				"\t" + pkg + "services/config.main\n" +
+				"\tstatic function call --> " + pkg + "pkg/logging.Error\n" +
+				"analysis/testdata/allinone/services/healthcheck/main.go does not reach " + pkg + "pkg/logging.Error, " +
+				"although it imports its package\n",
+		))
+
+		By("Explaining dead function")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, paths)
+		r.WhyLiveFlag = "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/cache.Delete"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/services/config/main.go does not reach " + pkg + "pkg/cache.Delete, " +
+				"although it imports its package\n" +
+				pkg + "pkg/cache.Delete is not reachable from any entrypoint\n",
+		))
+
+		By("Resolving package path with dots after the last slash")
+		stdOut.Reset()
+		r = analysis.New(stdOut, stdErr, paths)
+		r.WhyLiveFlag = "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/codec.v2.Encode"
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/services/config/main.go reaches " + pkg + "pkg/codec.v2.Encode:\n" +
+				"\t" + pkg + "services/config.main\n" +
+				"\tstatic function call --> " + pkg + "pkg/codec.v2.Encode\n",
+		))
+	})
+
+	It("fails on unknown whylive function", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.WhyLiveFlag = "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging.Unknown"
+		Expect(r.Run(ctx)).To(MatchError(
+			"function github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging.Unknown not found in any entrypoint",
+		))
+
+		r.WhyLiveFlag = "logging"
+		Expect(r.Run(ctx)).To(MatchError(HavePrefix("invalid whylive function 'logging'")))
+	})
+
+	It("Attributes live functions of shared packages to entrypoints", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{
+			"testdata/allinone/services/authn/main.go",
+			"testdata/allinone/services/config/main.go",
+			"testdata/allinone/services/healthcheck/main.go",
+		})
+		r.AttributionFlag = true
+		Expect(r.Run(ctx)).To(Succeed())
+
+		authn := "analysis/testdata/allinone/services/authn/main.go"
+		config := "analysis/testdata/allinone/services/config/main.go"
+		healthcheck := "analysis/testdata/allinone/services/healthcheck/main.go"
+		candidate := ", candidate to move into analysis/testdata/allinone/services/healthcheck/internal\n"
+		Expect(stdOut.String()).To(Equal(
+			"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/http imported by 2 entrypoints:\n" +
+				"\tanalysis/testdata/allinone/pkg/http/http.go:5:6: New reached by " + authn + ", " + healthcheck + "\n" +
+				"\tanalysis/testdata/allinone/pkg/http/http.go:9:6: Get reached only by " + healthcheck + candidate +
+				"\tanalysis/testdata/allinone/pkg/http/http.go:13:6: Post reached only by " + healthcheck + candidate +
+				"\tanalysis/testdata/allinone/pkg/http/http.go:17:6: Put reached only by " + healthcheck + candidate +
+				"\tanalysis/testdata/allinone/pkg/http/http.go:21:6: Delete reached by " + authn + ", " + healthcheck + "\n" +
+				"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging imported by 3 entrypoints:\n" +
+				"\tanalysis/testdata/allinone/pkg/logging/logging.go:3:6: New reached by " + authn + ", " + healthcheck + "\n" +
+				"\tanalysis/testdata/allinone/pkg/logging/logging.go:9:6: Info reached by " + authn + ", " + healthcheck + "\n" +
+				"\tanalysis/testdata/allinone/pkg/logging/logging.go:15:6: Error reached by " + authn + ", " + config + "\n",
+		))
+	})
+
+	It("Writes multiple outputs", func() {
+		ctx := context.Background()
+		dir := GinkgoT().TempDir()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FilterFlag = "/pkg/logging"
+		r.Outputs = []analysis.Output{
+			{Format: "json", Path: filepath.Join(dir, "report.json")},
+			{Format: "github", Path: filepath.Join(dir, "report.txt")},
+		}
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(stdOut.String()).To(Equal(
+			"analysis/testdata/allinone/pkg/logging/logging.go:12:6: unreachable func: Warn\n" +
+				"analysis/testdata/allinone/pkg/logging/logging.go:6:6: unreachable func: Debug\n",
+		))
+
+		var out []*analysis.Package
+		data, err := os.ReadFile(filepath.Join(dir, "report.json"))
+		Expect(err).To(Succeed())
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out).To(HaveLen(1))
+		Expect(out[0].Funcs).To(HaveLen(2))
+
+		data, err = os.ReadFile(filepath.Join(dir, "report.txt"))
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(HavePrefix("::warning file="))
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).To(Succeed())
+		Expect(entries).To(HaveLen(2), "temporary files should be removed")
+	})
+
+	It("fails on invalid output", func() {
+		_, err := analysis.ParseOutput("report.sarif")
+		Expect(err).To(MatchError("invalid output 'report.sarif', expected format=path"))
+
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.Outputs = []analysis.Output{{Format: "yaml", Path: "report.yaml"}}
+		Expect(r.Run(ctx)).To(MatchError(HavePrefix("unknown output format 'yaml' of report.yaml, expected one of: ")))
+	})
+
+	It("fails on unknown output format", func() {
+		ctx := context.Background()
+		r := analysis.New(stdOut, stdErr, []string{"testdata/allinone/services/authn/main.go"})
+		r.FormatFlag = "yaml"
+		Expect(r.Run(ctx)).To(MatchError("unknown output format 'yaml', expected one of: " +
+			"text, json, jsonl, sarif, github, gitlab, checkstyle, junit, markdown, rdjson, rdjsonl, csv"))
+	})
 })
@@ -0,0 +1,175 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"runtime/debug"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "deadmono"
+	toolURI      = "https://github.com/arxeiss/deadmono"
+)
+
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		Version        string      `json:"version,omitempty"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID                   string             `json:"id"`
+		ShortDescription     sarifMessage       `json:"shortDescription"`
+		DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	}
+
+	sarifConfiguration struct {
+		Level string `json:"level"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifResult struct {
+		RuleID           string                 `json:"ruleId"`
+		RuleIndex        int                    `json:"ruleIndex"`
+		Level            string                 `json:"level"`
+		Message          sarifMessage           `json:"message"`
+		Locations        []sarifLocation        `json:"locations"`
+		LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           sarifRegion           `json:"region"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+	}
+
+	sarifLogicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+		Kind               string `json:"kind"`
+	}
+)
+
+// Rule IDs of findings, each kind of dead function has own rule.
+const (
+	ruleUnreachableFunc = "unreachable-func"
+	ruleGenerated       = "unreachable-generated-func"
+)
+
+var sarifRules = []sarifRule{
+	{
+		ID:                   ruleUnreachableFunc,
+		ShortDescription:     sarifMessage{Text: "Function is unreachable from all entrypoints"},
+		DefaultConfiguration: sarifConfiguration{Level: "warning"},
+	},
+	{
+		ID:                   ruleGenerated,
+		ShortDescription:     sarifMessage{Text: "Function in generated Go file is unreachable from all entrypoints"},
+		DefaultConfiguration: sarifConfiguration{Level: "note"},
+	},
+}
+
+// printSARIF prints SARIF log with one result per dead function.
+// Code scanning resolves paths from the repository root, so they are relative to $GITHUB_WORKSPACE, if it is set.
+func (r *Runner) printSARIF(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	results := make([]sarifResult, 0)
+	for _, pkg := range sortedPackages(deadCode) {
+		for _, fun := range pkg.Funcs {
+			ruleIndex := ruleIndexOf(fun)
+			results = append(results, sarifResult{
+				RuleID:    sarifRules[ruleIndex].ID,
+				RuleIndex: ruleIndex,
+				Level:     sarifRules[ruleIndex].DefaultConfiguration.Level,
+				Message:   sarifMessage{Text: "unreachable func: " + fun.Name},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: fileURI(r.checkoutPath("GITHUB_WORKSPACE", fun.Position.File))},
+						Region:           sarifRegion{StartLine: fun.Position.Line, StartColumn: fun.Position.Col},
+					},
+				}},
+				LogicalLocations: []sarifLogicalLocation{{
+					FullyQualifiedName: pkg.Path + "." + fun.Name,
+					Kind:               "function",
+				}},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           toolName,
+				Version:        toolVersion(),
+				InformationURI: toolURI,
+				Rules:          sarifRules,
+			}},
+			Results: results,
+		}},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(log)
+}
+
+// ruleIndexOf returns index of the rule in sarifRules matching the kind of dead function.
+func ruleIndexOf(fun *Function) int {
+	if fun.Generated {
+		return 1
+	}
+	return 0
+}
+
+// fileURI returns relative paths as they are, absolute paths (with multiple modules) as file:// URI.
+func fileURI(file string) string {
+	if !filepath.IsAbs(file) {
+		return filepath.ToSlash(file)
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(file)}).String()
+}
+
+// toolVersion returns version of deadmono module, or empty string when built from source.
+func toolVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "(devel)" {
+		return ""
+	}
+	return info.Main.Version
+}
@@ -0,0 +1,137 @@
+package analysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+const (
+	// ignoreDirective in the doc comment of function removes the function from results.
+	ignoreDirective = "//deadmono:ignore"
+	// ignorePackageDirective in the comment before package clause removes all functions of the package from results.
+	ignorePackageDirective = "//deadmono:ignore-package"
+)
+
+// suppression is an inline directive suppressing a function or whole package.
+type suppression struct {
+	pkg *Package
+	// fun is a suppressed function, for package suppression it is the function matched by the directive.
+	fun *Function
+	// pos is a position of the directive for package suppression.
+	pos    Position
+	reason string
+	used   bool
+}
+
+// findDirective returns the reason of the directive, if any of comment groups contains it.
+func findDirective(directive string, groups ...*ast.CommentGroup) (reason string, pos token.Pos, found bool) {
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			rest, ok := strings.CutPrefix(c.Text, directive)
+			// Make sure //deadmono:ignore doesn't match //deadmono:ignore-package.
+			if !ok || (rest != "" && rest[0] != ' ' && rest[0] != '\t') {
+				continue
+			}
+			return strings.TrimSpace(rest), c.Pos(), true
+		}
+	}
+	return "", token.NoPos, false
+}
+
+// applySuppressions removes functions suppressed by inline directives from the dead code.
+// Returns suppressed findings and suppressions which are no longer needed, because the function became live.
+func applySuppressions(
+	eps []*entrypointInfo, deadCode map[string]deadPackageFuncs,
+) (suppressed, unnecessary []*suppression) {
+	funcSuppressions := make(map[string]*suppression)
+	pkgSuppressions := make(map[string]*suppression)
+	for _, ep := range eps {
+		for key, s := range ep.suppressions {
+			funcSuppressions[key] = s
+		}
+		for pkg, s := range ep.pkgSuppressions {
+			pkgSuppressions[pkg] = s
+		}
+	}
+
+	for pkg, dpf := range deadCode {
+		for key, fun := range dpf.funcs {
+			if s, found := funcSuppressions[suppressionKey(pkg, key)]; found {
+				s.used = true
+				suppressed = append(suppressed, s)
+				delete(dpf.funcs, key)
+				continue
+			}
+			if s, found := pkgSuppressions[pkg]; found {
+				s.used = true
+				suppressed = append(suppressed, &suppression{pkg: s.pkg, fun: fun, pos: s.pos, reason: s.reason})
+				delete(dpf.funcs, key)
+			}
+		}
+	}
+
+	for _, s := range funcSuppressions {
+		if !s.used {
+			unnecessary = append(unnecessary, s)
+		}
+	}
+	for _, s := range pkgSuppressions {
+		if !s.used {
+			unnecessary = append(unnecessary, s)
+		}
+	}
+	return sortSuppressions(suppressed), sortSuppressions(unnecessary)
+}
+
+func suppressionKey(pkg, key string) string {
+	return pkg + ":" + key
+}
+
+func sortSuppressions(suppressions []*suppression) []*suppression {
+	slices.SortFunc(suppressions, func(a, b *suppression) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return suppressions
+}
+
+// Position returns the position of suppressed function or position of the package directive.
+func (s *suppression) Position() Position {
+	if s.fun != nil {
+		return s.fun.Position
+	}
+	return s.pos
+}
+
+func (s *suppression) String() string {
+	return s.Position().String()
+}
+
+func (r *Runner) printSuppressions(suppressed, unnecessary []*suppression) error {
+	if r.ShowSuppressedFlag {
+		for _, s := range suppressed {
+			msg := fmt.Sprintf("%s: suppressed unreachable func: %s", s, s.fun.Name)
+			if s.reason != "" {
+				msg += " (" + s.reason + ")"
+			}
+			r.writeStderr("%s", msg)
+		}
+	}
+
+	if !r.CheckSuppressionsFlag || len(unnecessary) == 0 {
+		return nil
+	}
+	for _, s := range unnecessary {
+		if s.fun != nil {
+			r.writeStderr("%s: unnecessary suppression of live func: %s", s, s.fun.Name)
+		} else {
+			r.writeStderr("%s: unnecessary suppression of package without unreachable funcs: %s", s, s.pkg.Path)
+		}
+	}
+	return fmt.Errorf("found %d unnecessary suppressions", len(unnecessary))
+}
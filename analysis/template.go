@@ -0,0 +1,47 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// templateFunc is a data of template executed per function, it is the Function with its Package.
+type templateFunc struct {
+	*Function
+	Package *Package
+}
+
+func (r *Runner) compileTemplate() error {
+	if r.TemplateFlag == "" {
+		return nil
+	}
+	if r.format() != "text" {
+		return fmt.Errorf("template cannot be combined with %s output format", r.format())
+	}
+	tmpl, err := template.New(toolName).Parse(r.TemplateFlag)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	r.template = tmpl
+	return nil
+}
+
+// printTemplate executes the template per Package, same as deadcode -f flag, or per Function with TemplatePerFuncFlag.
+func (r *Runner) printTemplate(_ context.Context, w io.Writer, deadCode map[string]deadPackageFuncs) error {
+	for _, pkg := range sortedPackages(deadCode) {
+		if !r.TemplatePerFuncFlag {
+			if err := r.template.Execute(w, pkg); err != nil {
+				return fmt.Errorf("failed to execute template: %w", err)
+			}
+			continue
+		}
+		for _, fun := range pkg.Funcs {
+			if err := r.template.Execute(w, templateFunc{Function: fun, Package: pkg}); err != nil {
+				return fmt.Errorf("failed to execute template: %w", err)
+			}
+		}
+	}
+	return nil
+}
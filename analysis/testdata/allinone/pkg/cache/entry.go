@@ -0,0 +1,10 @@
+package cache
+
+// Entry is implemented only by types of this package.
+type Entry interface {
+	isEntry()
+}
+
+type item struct{}
+
+func (item) isEntry() {}
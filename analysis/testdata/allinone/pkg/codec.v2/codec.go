@@ -0,0 +1,4 @@
+package codec
+
+func Encode() {
+}
@@ -0,0 +1,10 @@
+// Package legacy is kept until all clients are migrated.
+//
+//deadmono:ignore-package deprecated, will be removed
+package legacy
+
+func Init() {
+}
+
+func Migrate() {
+}
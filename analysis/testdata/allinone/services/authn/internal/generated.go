@@ -4,3 +4,7 @@ package internal
 
 func Generated() {
 }
+
+//deadmono:ignore kept for compatibility of the generator
+func GeneratedHook() {
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/Masterminds/semver/v3"
 
 	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/cache"
+	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/codec.v2"
+	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/legacy"
 	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/logging"
 )
 
@@ -14,5 +16,9 @@ func main() {
 	cache.Get()
 	cache.Set()
 
+	codec.Encode()
+
 	logging.Error()
+
+	legacy.Init()
 }
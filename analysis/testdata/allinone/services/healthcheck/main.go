@@ -1,6 +1,9 @@
 package main
 
-import "github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/http"
+import (
+	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/http"
+	"github.com/arxeiss/deadmono/analysis/testdata/allinone/pkg/metrics"
+)
 
 func main() {
 	http.New()
@@ -8,4 +11,7 @@ func main() {
 	http.Post()
 	http.Put()
 	http.Delete()
+
+	metrics.Inc()
+	metrics.Observe()
 }
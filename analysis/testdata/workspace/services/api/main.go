@@ -0,0 +1,7 @@
+package main
+
+import "example.com/workspace/shared/text"
+
+func main() {
+	_ = text.Upper("api")
+}
@@ -0,0 +1,10 @@
+package internal
+
+import "example.com/workspace/shared/text"
+
+func Work() {
+	_ = text.Lower("WORKER")
+}
+
+func Retry() {
+}
@@ -0,0 +1,7 @@
+package main
+
+import "example.com/workspace/worker/internal"
+
+func main() {
+	internal.Work()
+}
@@ -0,0 +1,5 @@
+package testutil
+
+func Equal(a, b string) bool {
+	return a == b
+}
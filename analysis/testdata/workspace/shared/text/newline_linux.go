@@ -0,0 +1,5 @@
+package text
+
+func Newline() string {
+	return "\n"
+}
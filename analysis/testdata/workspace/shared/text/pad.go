@@ -0,0 +1,11 @@
+package text
+
+import "strings"
+
+func PadLeft(s string, n int) string {
+	return strings.Repeat(" ", n) + s
+}
+
+func PadRight(s string, n int) string {
+	return s + strings.Repeat(" ", n)
+}
@@ -0,0 +1,13 @@
+package text
+
+// Style is a text style.
+type Style int
+
+const Bold Style = 1
+
+func (s Style) Apply(t string) string {
+	if s == Bold {
+		return Upper(t)
+	}
+	return t
+}
@@ -0,0 +1,15 @@
+package text
+
+import "strings"
+
+func Upper(s string) string {
+	return strings.ToUpper(s)
+}
+
+func Lower(s string) string {
+	return strings.ToLower(s)
+}
+
+func Title(s string) string {
+	return Upper(s[:1]) + s[1:]
+}
@@ -0,0 +1,13 @@
+package text
+
+import (
+	"testing"
+
+	"example.com/workspace/shared/testutil"
+)
+
+func TestUpper(t *testing.T) {
+	if !testutil.Equal(Upper("a"), "A") {
+		t.Fail()
+	}
+}
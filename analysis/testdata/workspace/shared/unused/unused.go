@@ -0,0 +1,7 @@
+package unused
+
+import "example.com/workspace/shared/text"
+
+func Shout(s string) string {
+	return text.Upper(s) + "!"
+}
@@ -0,0 +1,13 @@
+package unused_test
+
+import (
+	"testing"
+
+	"example.com/workspace/shared/unused"
+)
+
+func TestShout(t *testing.T) {
+	if unused.Shout("a") != "A!" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,7 @@
+package unused
+
+import "example.com/workspace/shared/text"
+
+func Whisper(s string) string {
+	return text.Lower(s) + "..."
+}
@@ -0,0 +1,167 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// unusedPackage is a package matching the filter, which is not imported by any entrypoint nor test.
+type unusedPackage struct {
+	path string
+	// dir is a directory of the package, in the same form as Position.File.
+	dir string
+	// files is a number of non-test Go files of the package.
+	files int
+}
+
+// listedPackage is a package of entrypoint modules listed by `go list`.
+type listedPackage struct {
+	path        string
+	name        string
+	dir         string
+	files       int
+	imports     []string
+	testImports []string
+}
+
+// listUnusedPackages returns packages of entrypoint modules matching the filter, which are not in dependencies
+// of any entrypoint nor imported by tests. Main packages and packages matching ignore patterns are skipped.
+func (r *Runner) listUnusedPackages(
+	ctx context.Context, eps []*entrypointInfo, ignore []*regexp.Regexp,
+) ([]*unusedPackage, error) {
+	// Within a workspace, all workspace modules can be listed at once, otherwise each module is listed separately.
+	dirs := make(map[string][]string)
+	if r.workspaceDir != "" {
+		dirs[r.workspaceDir] = r.workspaceModules
+	} else {
+		seen := make(map[string]bool)
+		for _, ep := range eps {
+			if !seen[ep.module] {
+				seen[ep.module] = true
+				dirs[filepath.Dir(ep.absPath)] = append(dirs[filepath.Dir(ep.absPath)], ep.module)
+			}
+		}
+	}
+
+	listed := make(map[string]*listedPackage)
+	filters := make(map[string]*regexp.Regexp)
+	for dir, modules := range dirs {
+		filter, err := r.compileFilter(modules)
+		if err != nil {
+			return nil, err
+		}
+		pkgs, err := r.listModulePackages(ctx, dir, modules)
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range pkgs {
+			listed[pkg.path] = pkg
+			filters[pkg.path] = filter
+		}
+	}
+
+	// Packages imported by tests of used packages are used too, so are all their dependencies within listed packages.
+	// Tests of unused packages are skipped, otherwise a package with an external test would never be reported.
+	used := make(map[string]bool)
+	queue := make([]string, 0)
+	for _, ep := range eps {
+		for dep := range ep.deps {
+			queue = append(queue, dep)
+		}
+	}
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+		if used[path] {
+			continue
+		}
+		used[path] = true
+		if pkg, found := listed[path]; found {
+			queue = append(queue, pkg.imports...)
+			queue = append(queue, pkg.testImports...)
+		}
+	}
+
+	unused := make([]*unusedPackage, 0)
+	for path, pkg := range listed {
+		if used[path] || pkg.name == "main" || pkg.files == 0 || !filters[path].MatchString(path) {
+			continue
+		}
+		dir, _ := strings.CutPrefix(pkg.dir, r.rootPath)
+		if slices.ContainsFunc(ignore, func(re *regexp.Regexp) bool {
+			return re.MatchString(path) || re.MatchString(dir)
+		}) {
+			continue
+		}
+		unused = append(unused, &unusedPackage{path: path, dir: dir, files: pkg.files})
+	}
+	slices.SortFunc(unused, func(a, b *unusedPackage) int {
+		return strings.Compare(a.path, b.path)
+	})
+	return unused, nil
+}
+
+// listModulePackages lists all packages of the modules, directories testdata and vendor are skipped.
+func (r *Runner) listModulePackages(ctx context.Context, dir string, modules []string) ([]*listedPackage, error) {
+	args := []string{
+		"list", "-e", "-tags=" + r.TagsFlag, "-f",
+		`{{.ImportPath}}{{"\t"}}{{.Name}}{{"\t"}}{{.Dir}}{{"\t"}}{{len .GoFiles}}{{"\t"}}{{len .CgoFiles}}{{"\t"}}` +
+			`{{join .Imports ","}}{{"\t"}}{{join .TestImports ","}}{{"\t"}}{{join .XTestImports ","}}`,
+	}
+	for _, module := range modules {
+		args = append(args, module+"/...")
+	}
+	out, err := getCommandStdout(ctx, dir, "go", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list module packages: %w", err)
+	}
+
+	pkgs := make([]*listedPackage, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		goFiles, _ := strconv.Atoi(fields[3])
+		cgoFiles, _ := strconv.Atoi(fields[4])
+		pkgs = append(pkgs, &listedPackage{
+			path:        fields[0],
+			name:        fields[1],
+			dir:         fields[2],
+			files:       goFiles + cgoFiles,
+			imports:     splitList(fields[5]),
+			testImports: append(splitList(fields[6]), splitList(fields[7])...),
+		})
+	}
+	r.writeDebug("Listed %d packages of modules %s", len(pkgs), strings.Join(modules, ", "))
+	return pkgs, nil
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// printUnusedPackages prints unused packages in the same form as unreachable functions in text output.
+func (r *Runner) printUnusedPackages(unused []*unusedPackage) {
+	for _, pkg := range unused {
+		r.printFinding("%s: unreachable package: %s (%d files)", pkg.dir, pkg.path, pkg.files)
+	}
+}
+
+// printFinding prints finding beyond unreachable functions into text output.
+// With other output formats, it is printed to stderr, so the output stays valid.
+func (r *Runner) printFinding(format string, args ...any) {
+	if r.format() == "text" && r.template == nil {
+		fmt.Fprintf(r.writer, format+"\n", args...)
+		return
+	}
+	r.writeStderr(format, args...)
+}
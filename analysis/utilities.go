@@ -2,16 +2,46 @@ package analysis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"os/exec"
+	"path/filepath"
 )
 
-func getCommandOutput(ctx context.Context, dir, name string, args ...string) ([]byte, error) {
+// getCommandStdout runs the command and returns only its standard output, so warnings printed to standard error
+// like "matched no packages" don't mix with it. Standard error is part of the returned error, if the command fails.
+func getCommandStdout(ctx context.Context, dir, name string, args ...string) ([]byte, error) {
 	cmd := exec.CommandContext(ctx, name, args...)
 	cmd.Dir = dir
-	out, err := cmd.CombinedOutput()
+	out, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("%s\nErr: %s", out, err.Error())
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("%s\nErr: %s", exitErr.Stderr, err.Error())
+		}
+		return nil, err
 	}
 	return out, nil
 }
+
+// findParentFile returns path of the file in the directory or the nearest parent directory containing it.
+// Empty path is returned, if there is no such file.
+func findParentFile(dir, name string) (string, error) {
+	for {
+		file := filepath.Join(dir, name)
+		info, err := os.Stat(file)
+		switch {
+		case err == nil && !info.IsDir():
+			return file, nil
+		case err != nil && !errors.Is(err, fs.ErrNotExist):
+			return "", fmt.Errorf("failed to find %s: %w", name, err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,165 @@
+package analysis
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/callgraph"
+	"golang.org/x/tools/go/callgraph/rta"
+	"golang.org/x/tools/go/ssa"
+)
+
+// whyLiveResult explains reachability of the function from single entrypoint.
+type whyLiveResult struct {
+	// declared is true if the function is declared in the program of the entrypoint.
+	declared bool
+	// imported is true if the main package of the entrypoint transitively imports package of the function.
+	imported bool
+	// path is the shortest call path from main, nil if the function is not reachable.
+	path []string
+}
+
+// splitQualifiedName splits fully qualified function name into package path and name, which can contain a type.
+func splitQualifiedName(qualified string) (pkgPath, name string, ok bool) {
+	slash := strings.LastIndex(qualified, "/")
+	dot := strings.Index(qualified[slash+1:], ".")
+	if dot < 0 {
+		return "", "", false
+	}
+	dot += slash + 1
+	pkgPath, name = qualified[:dot], qualified[dot+1:]
+	return pkgPath, name, pkgPath != "" && name != ""
+}
+
+// resolveQualifiedName splits fully qualified function name at the dot after which the package exists in the program.
+// Package path can contain dots after the last slash too, like gopkg.in/yaml.v3, so the first dot is not enough.
+// If no such package exists, it falls back to splitQualifiedName.
+func (p *program) resolveQualifiedName(qualified string) (pkg *ssa.Package, pkgPath, name string) {
+	slash := strings.LastIndex(qualified, "/")
+	for i := slash + 1; i < len(qualified); i++ {
+		if qualified[i] != '.' {
+			continue
+		}
+		if pkg := p.prog.ImportedPackage(qualified[:i]); pkg != nil {
+			return pkg, qualified[:i], qualified[i+1:]
+		}
+	}
+	pkgPath, name, _ = splitQualifiedName(qualified)
+	return nil, pkgPath, name
+}
+
+// qualifiedName returns fully qualified name of the function, same format as WhyLiveFlag.
+func qualifiedName(fn *ssa.Function) string {
+	if origin := fn.Origin(); origin != nil {
+		fn = origin
+	}
+	if fn.Pkg == nil {
+		return ""
+	}
+	return fn.Pkg.Pkg.Path() + "." + funcName(fn)
+}
+
+func explainWhyLive(
+	p *program, deps map[string]struct{}, roots []*ssa.Function, res *rta.Result, qualified string,
+) *whyLiveResult {
+	pkg, pkgPath, name := p.resolveQualifiedName(qualified)
+	_, imported := deps[pkgPath]
+	result := &whyLiveResult{declared: isDeclared(pkg, name), imported: imported}
+
+	// Breadth-first search from roots, so the found path is the shortest one.
+	prev := make(map[*callgraph.Node]*callgraph.Edge)
+	queue := make([]*callgraph.Node, 0)
+	for _, root := range roots {
+		if node := res.CallGraph.Nodes[root]; node != nil {
+			prev[node] = nil
+			queue = append(queue, node)
+		}
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if qualifiedName(node.Func) == qualified {
+			result.path = callPath(prev, node)
+			return result
+		}
+		for _, e := range node.Out {
+			if _, seen := prev[e.Callee]; !seen {
+				prev[e.Callee] = e
+				queue = append(queue, e.Callee)
+			}
+		}
+	}
+	return result
+}
+
+// callPath returns the root function followed by description of each call leading to the node.
+func callPath(prev map[*callgraph.Node]*callgraph.Edge, node *callgraph.Node) []string {
+	edges := make([]*callgraph.Edge, 0)
+	for e := prev[node]; e != nil; e = prev[e.Caller] {
+		edges = append(edges, e)
+		node = e.Caller
+	}
+	path := []string{node.Func.String()}
+	for _, e := range slices.Backward(edges) {
+		path = append(path, e.Description()+" --> "+e.Callee.Func.String())
+	}
+	return path
+}
+
+// isDeclared reports whether the package declares the function or method, name can be Func or Type.Method.
+func isDeclared(pkg *ssa.Package, name string) bool {
+	if pkg == nil {
+		return false
+	}
+	typeName, method, isMethod := strings.Cut(name, ".")
+	if !isMethod {
+		return pkg.Func(name) != nil
+	}
+	obj, ok := pkg.Pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return false
+	}
+	named, ok := types.Unalias(obj.Type()).(*types.Named)
+	if !ok {
+		return false
+	}
+	for i := range named.NumMethods() {
+		if named.Method(i).Name() == method {
+			return true
+		}
+	}
+	return false
+}
+
+// printWhyLive prints every entrypoint reaching WhyLiveFlag function with the shortest call path from it,
+// and entrypoints which import its package but don't reach it.
+func (r *Runner) printWhyLive(eps []*entrypointInfo) error {
+	declared := false
+	for _, ep := range eps {
+		declared = declared || ep.whyLive.declared
+	}
+	if !declared {
+		return fmt.Errorf("function %s not found in any entrypoint", r.WhyLiveFlag)
+	}
+
+	reached := 0
+	for _, ep := range eps {
+		name := r.scanName(ep)
+		switch {
+		case ep.whyLive.path != nil:
+			reached++
+			fmt.Fprintf(r.writer, "%s reaches %s:\n", name, r.WhyLiveFlag)
+			for _, call := range ep.whyLive.path {
+				fmt.Fprintf(r.writer, "\t%s\n", call)
+			}
+		case ep.whyLive.imported:
+			fmt.Fprintf(r.writer, "%s does not reach %s, although it imports its package\n", name, r.WhyLiveFlag)
+		}
+	}
+	if reached == 0 {
+		fmt.Fprintf(r.writer, "%s is not reachable from any entrypoint\n", r.WhyLiveFlag)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@
 The deadmono command reports unreachable functions across multiple entrypoints in Go monorepos.
 
 	Usage: deadmono [flags] path/to/main1.go path/to/main2.go ...
+	       deadmono -discover [flags] [packages]
 
 The deadmono command extends the functionality of the deadcode tool
 (https://pkg.go.dev/golang.org/x/tools/cmd/deadcode) to work with monorepos
@@ -15,9 +16,10 @@ truly unused code by finding the package based intersection of dead code across
 
 # How it works
 
-For each provided entrypoint (main.go file):
+All entrypoints of a Go module are loaded and type-checked only once.
+Then for each provided entrypoint (main.go file):
  1. Lists all dependencies
- 2. Runs deadcode analysis to find unreachable functions
+ 2. Runs deadcode analysis (SSA + Rapid Type Analysis) to find unreachable functions
  3. Intersects package based results across all entrypoints
  4. Reports only functions that are dead in ALL entrypoints
 
@@ -29,20 +31,137 @@ Analyze three services in a monorepo:
 
 This will report functions that are unused by all three services.
 
+# Discovering entrypoints
+
+Instead of listing all main files by hand, the -discover flag finds all main packages
+and uses them as entrypoints. Arguments are then package patterns, as for "go list".
+Without arguments, all main packages under the module root are discovered,
+or under roots of all workspace modules within a go.work workspace:
+
+	$ deadmono -discover
+	$ deadmono -discover -exclude "/tools/" ./services/...
+
+The -include and -exclude flags are regular expressions matched against the import path
+of discovered packages. Directories named testdata and vendor are skipped, same as "go list ./..." does.
+The discovered entrypoints are printed to stderr, so they can be reviewed.
+
+# Configuration file
+
+The configuration can be stored in .deadmono.yaml file at the module root. It is searched
+in the working directory and all its parents, or it can be set explicitly with the -config flag.
+Explicitly set flags override values from the file, and arguments replace the entrypoints.
+
+	entrypoints:               # paths or glob patterns relative to the configuration file
+	  - services/api/main.go
+	  - edge/agent/main.go@tags=rpi,goarch=arm64
+	filter: "<module>"
+	tags: ""
+	matrix: [linux/amd64, windows/amd64]
+	test: false
+	generated: false
+	unused_packages: false
+	dead_files: false
+	jobs: 0
+	ignore:                    # regular expressions matched against qualified function name or file path
+	  - _mock\.go$
+	show_suppressed: false
+	check_suppressions: false
+	baseline: ""               # path relative to the configuration file
+	output:
+	  json: false
+	  format: text
+	  files: [sarif=report.sarif] # paths relative to the configuration file
+	  html: ""                    # path relative to the configuration file
+	  source_url: https://github.com/myorg/repo/blob/{commit}/{path}#L{line}
+
 # Flags
 
-The -test flag causes it to analyze test executables too (passed to deadcode).
+The -test flag causes it to analyze test executables too (same as deadcode).
 
-The -generated flag includes dead functions in generated Go files (passed to deadcode).
+The -generated flag includes dead functions in generated Go files (same as deadcode).
 
-The -tags flag allows specifying build tags (passed to deadcode).
+The -tags flag allows specifying build tags (same as deadcode).
 
-The -filter flag allows filtering packages by regular expression (passed to deadcode).
+The -filter flag allows filtering packages by regular expression (same as deadcode).
 By default, it filters to the module of the first entrypoint ("<module>").
+Within a go.work workspace, it filters to all workspace modules ("<workspace>").
 When using a custom filter, entrypoints from different Go modules are supported.
 
 The -json flag outputs results in JSON format (same format as deadcode).
 
+The -format flag selects output format:
+  - text: default output, same as deadcode
+  - json: same as -json flag
+  - jsonl: JSON Lines, one self-contained object per dead function
+  - sarif: SARIF 2.1.0 log for code scanning dashboards, paths are relative to $GITHUB_WORKSPACE if set
+  - github: GitHub Actions workflow commands annotating dead functions on PR diffs,
+    paths are relative to $GITHUB_WORKSPACE
+  - gitlab: GitLab Code Quality report for merge requests, paths are relative to $CI_PROJECT_DIR
+  - checkstyle: Checkstyle XML report with dead functions grouped per file
+  - junit: JUnit XML report, entrypoints are test suites and packages with dead functions are failing test cases
+  - markdown: summary for pull request comments with a table of packages and collapsible per-package sections
+  - rdjson, rdjsonl: reviewdog diagnostic format, single result or one diagnostic per line,
+    paths are relative to $GITHUB_WORKSPACE or $CI_PROJECT_DIR
+  - csv: one row per dead function with the number of entrypoints importing its package
+
+The -o flag writes output in another format into file, as format=path, for example "sarif=deadmono.sarif".
+It can be repeated, all files are written atomically from the same analysis, in addition to the printed output.
+
+The -f flag formats output records using Go template, same as deadcode. The template is executed
+per package with the same data as -json output. With -template-per-func, it is executed
+per function instead, with .Package field of its package:
+
+	$ deadmono -template-per-func -f 'owner|{{.Package.Path}}|{{.Name}}{{"\n"}}' services/authn/main.go
+
+The -source-url flag is a template of links to source lines in markdown output,
+for example "https://github.com/org/repo/blob/{commit}/{path}#L{line}". The -commit flag
+is substituted for {commit} and it is required when the template contains it. {path} is the path
+relative to $GITHUB_WORKSPACE or $CI_PROJECT_DIR, or the printed path when neither is set,
+{line} is the line of the function. Functions outside of the checkout are not linked.
+
+The -html flag writes a self-contained HTML report into the file, in addition to the selected output format.
+It contains a package tree with counts of dead functions, entrypoints importing each package
+and source snippets around each dead function.
+
+The -matrix flag adds a build configuration in format goos/goarch[:tags], for example "linux/arm64:rpi".
+It can be repeated, then each entrypoint is analyzed with all configurations and a function
+is reported only if it is dead in every configuration that compiles its file.
+
+Each entrypoint can have own build settings in format path@key=value,key=value,
+for example "services/edge/main.go@tags=rpi,goarch=arm64". Supported keys are tags, goos, goarch,
+cgo (value of CGO_ENABLED) and env (KEY=VALUE, can be repeated).
+Entrypoint with own build settings is analyzed only with them, -matrix applies to other entrypoints.
+
+The -show-suppressed flag prints findings suppressed by inline directives to stderr.
+
+The -check-suppressions flag reports inline directives of functions which are no longer dead
+and of packages without any dead function, and fails if there are any.
+
+The -write-baseline flag records current dead functions into a baseline file, in the same format as -json output.
+The -baseline flag then reports only dead functions not recorded in the baseline file.
+Functions are matched by package path and name, so unrelated edits moving them don't invalidate the baseline.
+
+The -whylive flag explains which entrypoints reach the fully qualified function, for example
+"example.com/pkg.Func" or "example.com/pkg.Type.Method", instead of reporting dead code.
+Every entrypoint reaching it is printed with the shortest call path from main,
+and entrypoints importing its package without reaching it are listed too.
+
+The -unused-packages flag reports packages of entrypoint modules matching the filter,
+which are not imported by any entrypoint nor test, with their directory and number of Go files.
+Only tests of used packages count, so a package imported just by its own tests is still reported.
+Main packages are skipped. With output formats other than text, they are printed to stderr.
+
+The -dead-files flag reports files whose declared functions are all unreachable. Files declaring
+also types, vars, consts or init functions are marked, as those might be still used.
+With output formats other than text, they are printed to stderr.
+
+The -attribution flag reports live functions of packages imported by multiple entrypoints,
+with entrypoints reaching them, instead of reporting dead code. Functions reached by exactly one entrypoint
+are candidates to move into the internal package of that entrypoint.
+
+The -jobs flag limits how many entrypoints are scanned concurrently.
+By default, it is the number of CPUs. Output is the same regardless of the number of jobs.
+
 The -debug flag enables verbose debug output.
 
 # Output
@@ -51,13 +170,13 @@ The output format matches deadcode, with one difference: file path handling.
 Since deadmono analyzes multiple entrypoints, it uses a consistent path strategy:
 
   - Single module: Paths relative to go.mod when all entrypoints are in the same module
+  - Workspace: Paths relative to go.work when all entrypoints are in the same workspace
   - Multiple modules: Absolute paths when entrypoints span different modules
 
 # Requirements
 
-The deadcode tool must be installed:
-
-	$ go install golang.org/x/tools/cmd/deadcode@latest
+The Go toolchain must be in $PATH, as packages are loaded with "go list".
+The deadcode tool itself is not required, the analysis runs in-process.
 
 # Multiple Go Modules
 
@@ -67,10 +186,24 @@ custom regular expression to specify which packages to analyze:
 
 	$ deadmono -filter "github.com/myorg/.*" module1/main.go module2/main.go
 
+# Suppressing findings
+
+Functions kept on purpose can be suppressed by a directive in their doc comment,
+and all functions of a package by a directive in the comment before the package clause:
+
+	//deadmono:ignore called from assembly
+	//deadmono:ignore-package deprecated, will be removed
+
+# Go Workspaces
+
+When entrypoints are within a go.work workspace, it is detected automatically.
+Entrypoints from different workspace modules are supported without a custom filter,
+as all workspace modules are in scope. Paths are printed relative to the go.work directory.
+
 # Limitations
 
 The analysis inherits all limitations from the deadcode tool, including:
-  - Valid only for a single GOOS/GOARCH/-tags configuration
+  - Valid only for a single GOOS/GOARCH/-tags configuration, unless -matrix is used
   - Does not understand //go:linkname directives
   - Requires careful judgement before deleting reported functions
 
@@ -18,37 +18,113 @@ var (
 	//go:embed doc.go
 	doc string
 
-	debugFlag = flag.Bool("debug", false, "enable debug output")
-	helpFlag  = flag.Bool("help", false, "show help")
+	debugFlag  = flag.Bool("debug", false, "enable debug output")
+	helpFlag   = flag.Bool("help", false, "show help")
+	jobsFlag   = flag.Int("jobs", 0, "number of entrypoints scanned concurrently (default: number of CPUs)")
+	configFlag = flag.String("config", "",
+		"path to configuration file (default: "+analysis.ConfigFileName+" in working directory or any parent)")
+
+	discoverFlag = flag.Bool("discover", false,
+		"discover main packages matching arguments as entrypoints (default: all under module root)")
+	includeFlag = flag.String("include", "",
+		"discover only main packages with import path matching this regular expression")
+	excludeFlag = flag.String("exclude", "",
+		"skip discovered main packages with import path matching this regular expression")
 
 	testFlag = flag.Bool("test", false, "include implicit test packages and executables (deadcode flag)")
 	tagsFlag = flag.String("tags", "",
 		"comma-separated list of extra build tags (see: go help buildconstraint) (deadcode flag)")
 	filterFlag = flag.String("filter", "<module>",
-		"report only packages matching this regular expression (default: module of first package)")
+		"report only packages matching this regular expression (default: module of first package or workspace modules)")
+
+	whyLiveFlag = flag.String("whylive", "",
+		"explain which entrypoints reach the function (e.g. example.com/pkg.Func) and how, instead of reporting dead code")
+	attributionFlag = flag.Bool("attribution", false,
+		"report entrypoints reaching live functions of packages imported by multiple entrypoints, instead of dead code")
+	unusedPackagesFlag = flag.Bool("unused-packages", false,
+		"report packages matching filter which are not imported by any entrypoint nor test")
+	deadFilesFlag = flag.Bool("dead-files", false, "report files whose declared functions are all unreachable")
 
 	generatedFlag = flag.Bool("generated", false, "include dead functions in generated Go files (deadcode flag)")
 	jsonFlag      = flag.Bool("json", false, "output JSON records (deadcode flag)")
+	formatFlag    = flag.String("format", "text",
+		"output format, one of: "+strings.Join(analysis.OutputFormats, ", "))
+	templateFlag        = flag.String("f", "", "format output records per package using template (deadcode flag)")
+	templatePerFuncFlag = flag.Bool("template-per-func", false, "execute -f template per function instead of per package")
+
+	htmlFlag      = flag.String("html", "", "write self-contained HTML report with package tree into this file")
+	sourceURLFlag = flag.String("source-url", "",
+		"template of links to source lines in markdown output "+
+			"(e.g. https://github.com/org/repo/blob/{commit}/{path}#L{line})")
+	commitFlag = flag.String("commit", "", "commit SHA substituted for {commit} in -source-url")
+
+	showSuppressedFlag    = flag.Bool("show-suppressed", false, "print findings suppressed by //deadmono:ignore to stderr")
+	checkSuppressionsFlag = flag.Bool("check-suppressions", false,
+		"fail on //deadmono:ignore directives of functions which are no longer dead")
+
+	baselineFlag      = flag.String("baseline", "", "report only dead functions not recorded in this baseline file")
+	writeBaselineFlag = flag.String("write-baseline", "", "record current dead functions into this baseline file")
+
+	matrixFlag repeatableFlag
+	outputFlag repeatableFlag
 )
 
+func init() {
+	flag.Var(&matrixFlag, "matrix",
+		"build configuration goos/goarch[:tags] to analyze with, repeat for multiple configurations (e.g. linux/arm64:rpi)")
+	flag.Var(&outputFlag, "o",
+		"also write output in format into file as format=path, repeat for multiple files (e.g. sarif=report.sarif)")
+}
+
+// repeatableFlag is a flag collecting all values when repeated.
+type repeatableFlag []string
+
+func (f *repeatableFlag) String() string {
+	return strings.Join(*f, " ")
+}
+
+func (f *repeatableFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 func main() {
 	flag.Parse()
-	if len(flag.Args()) == 0 || *helpFlag {
+	if *helpFlag {
+		usage()
+		os.Exit(2)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	if len(cfg.Entrypoints) == 0 && !*discoverFlag {
 		usage()
 		os.Exit(2)
 	}
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
-	runner := analysis.New(os.Stdout, os.Stderr, flag.Args())
-	runner.DebugFlag = *debugFlag
-	runner.GeneratedFlag = *generatedFlag
-	runner.TestFlag = *testFlag
-	runner.TagsFlag = *tagsFlag
-	runner.JSONFlag = *jsonFlag
-	runner.FilterFlag = *filterFlag
+	if *discoverFlag {
+		cfg.Entrypoints, err = discover(ctx, flag.Args())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			cancel()
+			os.Exit(1)
+		}
+	}
 
-	err := runner.Run(ctx)
+	runner, err := analysis.NewFromConfig(os.Stdout, os.Stderr, cfg)
+	if err == nil {
+		runner.DebugFlag = *debugFlag
+		runner.WriteBaselineFlag = *writeBaselineFlag
+		runner.CommitFlag = *commitFlag
+		runner.WhyLiveFlag = *whyLiveFlag
+		runner.AttributionFlag = *attributionFlag
+		err = runner.Run(ctx)
+	}
 	cancel()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -59,6 +135,107 @@ func main() {
 	}
 }
 
+// loadConfig loads project configuration file, if any, and overrides its values by explicitly set flags.
+func loadConfig() (*analysis.Config, error) {
+	path := *configFlag
+	if path == "" {
+		var err error
+		path, err = analysis.FindConfig(".")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := &analysis.Config{}
+	if path != "" {
+		var err error
+		cfg, err = analysis.LoadConfig(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	setFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+	if setFlags["filter"] || cfg.Filter == "" {
+		cfg.Filter = *filterFlag
+	}
+	if setFlags["tags"] {
+		cfg.Tags = *tagsFlag
+	}
+	if setFlags["matrix"] {
+		cfg.Matrix = matrixFlag
+	}
+	if setFlags["jobs"] {
+		cfg.Jobs = *jobsFlag
+	}
+	if setFlags["test"] {
+		cfg.Test = *testFlag
+	}
+	if setFlags["generated"] {
+		cfg.Generated = *generatedFlag
+	}
+	if setFlags["unused-packages"] {
+		cfg.UnusedPackages = *unusedPackagesFlag
+	}
+	if setFlags["dead-files"] {
+		cfg.DeadFiles = *deadFilesFlag
+	}
+	if setFlags["show-suppressed"] {
+		cfg.ShowSuppressed = *showSuppressedFlag
+	}
+	if setFlags["check-suppressions"] {
+		cfg.CheckSuppressions = *checkSuppressionsFlag
+	}
+	if setFlags["baseline"] {
+		cfg.Baseline = *baselineFlag
+	}
+	if setFlags["json"] {
+		cfg.Output.JSON = *jsonFlag
+	}
+	if setFlags["format"] {
+		cfg.Output.Format = *formatFlag
+	}
+	if setFlags["f"] {
+		cfg.Output.Template = *templateFlag
+	}
+	if setFlags["template-per-func"] {
+		cfg.Output.TemplatePerFunc = *templatePerFuncFlag
+	}
+	if setFlags["o"] {
+		cfg.Output.Files = outputFlag
+	}
+	if setFlags["html"] {
+		cfg.Output.HTML = *htmlFlag
+	}
+	if setFlags["source-url"] {
+		cfg.Output.SourceURL = *sourceURLFlag
+	}
+	if len(flag.Args()) > 0 && !*discoverFlag {
+		cfg.Entrypoints = flag.Args()
+	}
+	return cfg, nil
+}
+
+func discover(ctx context.Context, patterns []string) ([]string, error) {
+	paths, err := analysis.DiscoverEntrypoints(ctx, "", patterns, *includeFlag, *excludeFlag)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no main packages discovered")
+	}
+
+	// Print discovered entrypoints to stderr, so they can be reviewed without affecting the output.
+	fmt.Fprintf(os.Stderr, "Discovered %d entrypoints:\n", len(paths))
+	for _, path := range paths {
+		fmt.Fprintf(os.Stderr, "  %s\n", path)
+	}
+	return paths, nil
+}
+
 func usage() {
 	// Extract the content of the /* ... */ comment in doc.go.
 	_, after, _ := strings.Cut(doc, "/*\n")